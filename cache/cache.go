@@ -0,0 +1,67 @@
+// Package cache persists each provider's program/scope snapshot to disk in a
+// versioned, gob-encoded format, guarded by a lockfile so a manually triggered
+// check can't race the scheduled loop and corrupt the file mid-write.
+package cache
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+
+	"github.com/nightlyone/lockfile"
+)
+
+// Cache reads and writes a single provider's Snapshot.
+type Cache struct {
+	path string
+	lock lockfile.Lockfile
+}
+
+// Open prepares a Cache backed by the file at path. The lockfile used to guard
+// reads/writes lives alongside it at "<path>.lock". nightlyone/lockfile
+// requires an absolute path, so path is resolved via filepath.Abs first --
+// callers are free to pass the relative paths they already build from cacheDir.
+func Open(path string) (*Cache, error) {
+	absPath, err := filepath.Abs(path)
+	if err != nil {
+		return nil, fmt.Errorf("cache: failed to resolve absolute path for %s: %w", path, err)
+	}
+
+	lock, err := lockfile.New(absPath + ".lock")
+	if err != nil {
+		return nil, fmt.Errorf("cache: failed to create lockfile: %w", err)
+	}
+	return &Cache{path: absPath, lock: lock}, nil
+}
+
+// Load reads and decodes the cache, migrating older schema versions to the
+// current Snapshot shape in the process. A missing file returns an error
+// satisfying os.IsNotExist so callers can special-case a first run.
+func (c *Cache) Load() (Snapshot, error) {
+	if err := c.lock.TryLock(); err != nil {
+		return Snapshot{}, fmt.Errorf("cache: failed to acquire lock on %s: %w", c.path, err)
+	}
+	defer c.lock.Unlock()
+
+	data, err := os.ReadFile(c.path)
+	if err != nil {
+		return Snapshot{}, err
+	}
+
+	return decode(data)
+}
+
+// Save encodes and writes the current snapshot, under the same lock as Load.
+func (c *Cache) Save(snapshot Snapshot) error {
+	if err := c.lock.TryLock(); err != nil {
+		return fmt.Errorf("cache: failed to acquire lock on %s: %w", c.path, err)
+	}
+	defer c.lock.Unlock()
+
+	data, err := encode(snapshot)
+	if err != nil {
+		return err
+	}
+
+	return os.WriteFile(c.path, data, 0644)
+}
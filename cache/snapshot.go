@@ -0,0 +1,23 @@
+package cache
+
+import (
+	"time"
+
+	"github.com/admiralhr99/bountyMonitor/providers"
+)
+
+// ProgramRecord is the per-program metadata the cache tracks alongside a
+// program's last-known scope, so that a program briefly dropping out of an
+// upstream feed doesn't read as a brand new program the moment it reappears.
+type ProgramRecord struct {
+	Program         providers.NormalizedProgram
+	FirstSeen       time.Time
+	LastSeen        time.Time
+	FailureCount    int
+	LastCheckedHash string
+}
+
+// Snapshot is a single provider's full cached state, keyed by program handle.
+type Snapshot struct {
+	Programs map[string]ProgramRecord
+}
@@ -0,0 +1,80 @@
+package cache
+
+import (
+	"bytes"
+	"encoding/gob"
+	"fmt"
+)
+
+// version identifies the on-disk schema of a cache payload. It is written as a
+// single byte ahead of the gob-encoded payload so that a future schema change
+// can be detected and migrated forward instead of discarding the whole cache.
+type version byte
+
+const currentVersion version = 1
+
+// payload is implemented by every on-disk cache schema. transformToCurrent walks
+// the (append-only) chain of schema changes and returns the current Snapshot
+// shape, regardless of which version was actually read from disk.
+type payload interface {
+	transformToCurrent() Snapshot
+}
+
+// decoders maps each on-disk version byte to a function that gob-decodes that
+// version's payload. Introducing schema version N+1 means adding its decoder
+// here and teaching its payload.transformToCurrent how to reach the new Snapshot
+// shape (chaining through v(N) if needed) -- existing v1 caches keep loading
+// as-is rather than being thrown away.
+var decoders = map[version]func([]byte) (payload, error){
+	1: decodeV1,
+}
+
+// v1Payload is the original (and, so far, only) cache schema: a flat map of
+// program handle to ProgramRecord.
+type v1Payload struct {
+	Programs map[string]ProgramRecord
+}
+
+func (p v1Payload) transformToCurrent() Snapshot {
+	return Snapshot{Programs: p.Programs}
+}
+
+func decodeV1(data []byte) (payload, error) {
+	var p v1Payload
+	if err := gob.NewDecoder(bytes.NewReader(data)).Decode(&p); err != nil {
+		return nil, err
+	}
+	return p, nil
+}
+
+// decode reads a versioned cache payload and migrates it to the current Snapshot shape.
+func decode(data []byte) (Snapshot, error) {
+	if len(data) == 0 {
+		return Snapshot{}, fmt.Errorf("cache: empty payload")
+	}
+
+	v := version(data[0])
+	decodeFn, ok := decoders[v]
+	if !ok {
+		return Snapshot{}, fmt.Errorf("cache: unknown cache version %d", v)
+	}
+
+	p, err := decodeFn(data[1:])
+	if err != nil {
+		return Snapshot{}, fmt.Errorf("cache: failed to decode version %d payload: %w", v, err)
+	}
+
+	return p.transformToCurrent(), nil
+}
+
+// encode writes the current snapshot as a version-prefixed gob payload.
+func encode(snapshot Snapshot) ([]byte, error) {
+	var buf bytes.Buffer
+	buf.WriteByte(byte(currentVersion))
+
+	if err := gob.NewEncoder(&buf).Encode(v1Payload{Programs: snapshot.Programs}); err != nil {
+		return nil, fmt.Errorf("cache: failed to encode payload: %w", err)
+	}
+
+	return buf.Bytes(), nil
+}
@@ -0,0 +1,36 @@
+package main
+
+import (
+	"fmt"
+
+	"github.com/spf13/cobra"
+)
+
+// checkCmd is the one-shot equivalent of the old `-now` flag.
+var checkCmd = &cobra.Command{
+	Use:   "check",
+	Short: "Run a single check against all configured providers and exit",
+	RunE: func(cmd *cobra.Command, args []string) error {
+		logFile, err := setupLogging()
+		if err != nil {
+			return fmt.Errorf("failed to open log file: %w", err)
+		}
+		defer logFile.Close()
+
+		if err := ensureCacheDir(); err != nil {
+			return fmt.Errorf("failed to create cache directory: %w", err)
+		}
+
+		cfg, err := loadConfig()
+		if err != nil {
+			return err
+		}
+
+		fmt.Println("Running check...")
+		if err := runCheck(cfg); err != nil {
+			return err
+		}
+		fmt.Println("Check complete.")
+		return nil
+	},
+}
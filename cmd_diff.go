@@ -0,0 +1,56 @@
+package main
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+
+	"github.com/spf13/cobra"
+
+	"github.com/admiralhr99/bountyMonitor/notify"
+	"github.com/admiralhr99/bountyMonitor/providers"
+)
+
+// diffCmd compares two arbitrary snapshot files without touching the cache --
+// e.g. a pair of normalized scope dumps saved from CI runs on different days.
+var diffCmd = &cobra.Command{
+	Use:   "diff <old.json> <new.json>",
+	Short: "Compare two normalized scope snapshots without touching the cache",
+	Args:  cobra.ExactArgs(2),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		oldPrograms, err := readSnapshotFile(args[0])
+		if err != nil {
+			return fmt.Errorf("failed to read %s: %w", args[0], err)
+		}
+
+		newPrograms, err := readSnapshotFile(args[1])
+		if err != nil {
+			return fmt.Errorf("failed to read %s: %w", args[1], err)
+		}
+
+		changes := findChanges("diff", oldPrograms, newPrograms)
+		if changes.IsEmpty() {
+			fmt.Println("No differences found.")
+			return nil
+		}
+
+		fmt.Print(notify.RenderText(changes))
+		return nil
+	},
+}
+
+// readSnapshotFile reads a JSON array of providers.NormalizedProgram, the same
+// shape `dump` produces -- export's flattened exportRow is a different, lossy
+// shape and won't round-trip through this.
+func readSnapshotFile(path string) ([]providers.NormalizedProgram, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+
+	var programs []providers.NormalizedProgram
+	if err := json.Unmarshal(data, &programs); err != nil {
+		return nil, err
+	}
+	return programs, nil
+}
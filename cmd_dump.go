@@ -0,0 +1,53 @@
+package main
+
+import (
+	"encoding/json"
+	"os"
+	"path/filepath"
+
+	"github.com/spf13/cobra"
+
+	"github.com/admiralhr99/bountyMonitor/cache"
+	"github.com/admiralhr99/bountyMonitor/providers"
+)
+
+// dumpCmd writes the current cache as a JSON array of providers.NormalizedProgram
+// -- the shape `diff` reads (see readSnapshotFile in cmd_diff.go), unlike
+// export's flattened exportRow.
+var dumpCmd = &cobra.Command{
+	Use:   "dump",
+	Short: "Dump the current cache as a NormalizedProgram snapshot, for feeding to `diff`",
+	RunE: func(cmd *cobra.Command, args []string) error {
+		cfg, err := loadConfig()
+		if err != nil {
+			return err
+		}
+
+		enabled, err := cfg.ResolvedProviders()
+		if err != nil {
+			return err
+		}
+
+		var programs []providers.NormalizedProgram
+		for _, provider := range enabled {
+			providerCache, err := cache.Open(filepath.Join(cacheDir, provider.CacheKey()))
+			if err != nil {
+				return err
+			}
+
+			snapshot, err := providerCache.Load()
+			if err != nil {
+				// No cache yet for this provider -- nothing to dump.
+				continue
+			}
+
+			for _, record := range snapshot.Programs {
+				programs = append(programs, record.Program)
+			}
+		}
+
+		enc := json.NewEncoder(os.Stdout)
+		enc.SetIndent("", "  ")
+		return enc.Encode(programs)
+	},
+}
@@ -0,0 +1,110 @@
+package main
+
+import (
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+
+	"github.com/spf13/cobra"
+
+	"github.com/admiralhr99/bountyMonitor/cache"
+)
+
+var exportFormat string
+
+// exportRow is one in-scope target, flattened for piping into recon tools
+// like subfinder/httpx regardless of the output format chosen.
+type exportRow struct {
+	Provider string `json:"provider"`
+	Program  string `json:"program"`
+	Asset    string `json:"asset"`
+	Type     string `json:"type"`
+}
+
+var exportCmd = &cobra.Command{
+	Use:   "export",
+	Short: "Dump the current normalized scope table for piping into recon tools",
+	RunE: func(cmd *cobra.Command, args []string) error {
+		cfg, err := loadConfig()
+		if err != nil {
+			return err
+		}
+
+		enabled, err := cfg.ResolvedProviders()
+		if err != nil {
+			return err
+		}
+
+		var rows []exportRow
+		for _, provider := range enabled {
+			providerCache, err := cache.Open(filepath.Join(cacheDir, provider.CacheKey()))
+			if err != nil {
+				return err
+			}
+
+			snapshot, err := providerCache.Load()
+			if err != nil {
+				// No cache yet for this provider -- nothing to export.
+				continue
+			}
+
+			for _, record := range snapshot.Programs {
+				for _, scope := range record.Program.InScope {
+					rows = append(rows, exportRow{
+						Provider: provider.Name(),
+						Program:  record.Program.Handle,
+						Asset:    scope.AssetIdentifier,
+						Type:     scope.AssetType,
+					})
+				}
+			}
+		}
+
+		switch exportFormat {
+		case "json":
+			return exportJSON(rows)
+		case "csv":
+			return exportCSV(rows)
+		case "md":
+			return exportMarkdown(rows)
+		default:
+			return fmt.Errorf("unsupported export format %q (want json, csv, or md)", exportFormat)
+		}
+	},
+}
+
+func init() {
+	exportCmd.Flags().StringVar(&exportFormat, "format", "json", "output format: json, csv, or md")
+}
+
+func exportJSON(rows []exportRow) error {
+	enc := json.NewEncoder(os.Stdout)
+	enc.SetIndent("", "  ")
+	return enc.Encode(rows)
+}
+
+func exportCSV(rows []exportRow) error {
+	w := csv.NewWriter(os.Stdout)
+	defer w.Flush()
+
+	if err := w.Write([]string{"provider", "program", "asset", "type"}); err != nil {
+		return err
+	}
+	for _, row := range rows {
+		if err := w.Write([]string{row.Provider, row.Program, row.Asset, row.Type}); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func exportMarkdown(rows []exportRow) error {
+	fmt.Println("| Provider | Program | Asset | Type |")
+	fmt.Println("|---|---|---|---|")
+	for _, row := range rows {
+		fmt.Printf("| %s | %s | %s | %s |\n", row.Provider, row.Program, row.Asset, row.Type)
+	}
+	return nil
+}
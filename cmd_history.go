@@ -0,0 +1,114 @@
+package main
+
+import (
+	"fmt"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/spf13/cobra"
+
+	"github.com/admiralhr99/bountyMonitor/history"
+)
+
+var historyServeAddr string
+
+var historyCmd = &cobra.Command{
+	Use:   "history",
+	Short: "Query the persistent change history recorded by runCheck",
+}
+
+var historySinceCmd = &cobra.Command{
+	Use:   "since <duration>",
+	Short: "List events recorded since the given duration ago (e.g. 7d, 24h)",
+	Args:  cobra.ExactArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		d, err := parseHistoryDuration(args[0])
+		if err != nil {
+			return err
+		}
+		return withHistoryStore(func(store *history.Store) error {
+			events, err := store.Since(time.Now().Add(-d))
+			if err != nil {
+				return err
+			}
+			printHistoryEvents(events)
+			return nil
+		})
+	},
+}
+
+var historyProgramCmd = &cobra.Command{
+	Use:   "program <handle>",
+	Short: "List events recorded for a single program",
+	Args:  cobra.ExactArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		return withHistoryStore(func(store *history.Store) error {
+			events, err := store.ByProgram(args[0])
+			if err != nil {
+				return err
+			}
+			printHistoryEvents(events)
+			return nil
+		})
+	},
+}
+
+var historyAssetCmd = &cobra.Command{
+	Use:   "asset <type> <identifier>",
+	Short: "List events recorded for a single asset, e.g. asset wildcard '*.example.com'",
+	Args:  cobra.ExactArgs(2),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		return withHistoryStore(func(store *history.Store) error {
+			events, err := store.ByAsset(args[0], args[1])
+			if err != nil {
+				return err
+			}
+			printHistoryEvents(events)
+			return nil
+		})
+	},
+}
+
+func init() {
+	historyCmd.PersistentFlags().StringVar(&historyServeAddr, "serve", "", "instead of printing results, serve a read-only JSON API over the history store at this address (e.g. :8080)")
+	historyCmd.AddCommand(historySinceCmd, historyProgramCmd, historyAssetCmd)
+}
+
+// withHistoryStore opens the shared history store and, unless --serve was
+// given, hands it to run. With --serve, it serves a JSON API over the store
+// instead and run is never called.
+func withHistoryStore(run func(store *history.Store) error) error {
+	store, err := history.Open(filepath.Join(cacheDir, "history.db"))
+	if err != nil {
+		return err
+	}
+	defer store.Close()
+
+	if historyServeAddr != "" {
+		fmt.Printf("Serving history API on %s\n", historyServeAddr)
+		return store.Serve(historyServeAddr)
+	}
+
+	return run(store)
+}
+
+// parseHistoryDuration parses a duration like "7d" or "24h". time.ParseDuration
+// doesn't understand a "d" unit, so a trailing "d" is converted to hours first.
+func parseHistoryDuration(s string) (time.Duration, error) {
+	if days, ok := strings.CutSuffix(s, "d"); ok {
+		n, err := strconv.Atoi(days)
+		if err != nil {
+			return 0, fmt.Errorf("invalid duration %q: %w", s, err)
+		}
+		return time.Duration(n) * 24 * time.Hour, nil
+	}
+	return time.ParseDuration(s)
+}
+
+func printHistoryEvents(events []history.Event) {
+	for _, e := range events {
+		fmt.Printf("%s\t%s\t%s\t%s\n", e.TS.Format(time.RFC3339), e.Kind, e.ProgramHandle, e.PayloadJSON)
+	}
+}
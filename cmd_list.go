@@ -0,0 +1,65 @@
+package main
+
+import (
+	"fmt"
+	"path/filepath"
+	"strings"
+
+	"github.com/spf13/cobra"
+
+	"github.com/admiralhr99/bountyMonitor/cache"
+)
+
+var (
+	listProgram   string
+	listAssetType string
+)
+
+var listCmd = &cobra.Command{
+	Use:   "list",
+	Short: "Query the current cache for in-scope targets",
+	RunE: func(cmd *cobra.Command, args []string) error {
+		cfg, err := loadConfig()
+		if err != nil {
+			return err
+		}
+
+		enabled, err := cfg.ResolvedProviders()
+		if err != nil {
+			return err
+		}
+
+		for _, provider := range enabled {
+			providerCache, err := cache.Open(filepath.Join(cacheDir, provider.CacheKey()))
+			if err != nil {
+				return err
+			}
+
+			snapshot, err := providerCache.Load()
+			if err != nil {
+				// No cache yet for this provider -- nothing to list.
+				continue
+			}
+
+			for _, record := range snapshot.Programs {
+				program := record.Program
+				if listProgram != "" && !strings.EqualFold(program.Handle, listProgram) {
+					continue
+				}
+				for _, scope := range program.InScope {
+					if listAssetType != "" && !strings.EqualFold(scope.AssetType, listAssetType) {
+						continue
+					}
+					fmt.Printf("%s\t%s\t[%s]\t%s\n", provider.Name(), program.Handle, scope.AssetType, scope.AssetIdentifier)
+				}
+			}
+		}
+
+		return nil
+	},
+}
+
+func init() {
+	listCmd.Flags().StringVar(&listProgram, "program", "", "only list scopes for this program handle")
+	listCmd.Flags().StringVar(&listAssetType, "asset-type", "", "only list scopes of this asset type (e.g. WILDCARD)")
+}
@@ -0,0 +1,51 @@
+package main
+
+import (
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/spf13/cobra"
+)
+
+var monitorCmd = &cobra.Command{
+	Use:   "monitor",
+	Short: "Run the check on a recurring schedule until interrupted",
+	RunE: func(cmd *cobra.Command, args []string) error {
+		logFile, err := setupLogging()
+		if err != nil {
+			return fmt.Errorf("failed to open log file: %w", err)
+		}
+		defer logFile.Close()
+
+		log.Println("Starting bounty monitor service")
+
+		if err := ensureCacheDir(); err != nil {
+			return fmt.Errorf("failed to create cache directory: %w", err)
+		}
+
+		cfg, err := loadConfig()
+		if err != nil {
+			return err
+		}
+
+		if err := runCheck(cfg); err != nil {
+			log.Printf("Error in initial check: %v", err)
+		}
+
+		ticker := time.NewTicker(checkInterval)
+		defer ticker.Stop()
+
+		fmt.Println("Bounty monitor is running. Press Ctrl+C to stop.")
+		fmt.Printf("Will check for updates every %s\n", checkInterval)
+
+		for range ticker.C {
+			log.Println("Running scheduled check")
+			if err := runCheck(cfg); err != nil {
+				log.Printf("Error in scheduled check: %v", err)
+			}
+		}
+
+		return nil
+	},
+}
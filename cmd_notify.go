@@ -0,0 +1,64 @@
+package main
+
+import (
+	"fmt"
+
+	"github.com/spf13/cobra"
+
+	"github.com/admiralhr99/bountyMonitor/notify"
+	"github.com/admiralhr99/bountyMonitor/providers"
+)
+
+var notifyCmd = &cobra.Command{
+	Use:   "notify",
+	Short: "Interact with configured notifier sinks",
+}
+
+var notifyTestCmd = &cobra.Command{
+	Use:   "test <sink>",
+	Short: "Fire a synthetic change through one configured notifier sink",
+	Args:  cobra.ExactArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		cfg, err := loadConfig()
+		if err != nil {
+			return err
+		}
+
+		sinkName := args[0]
+		for _, notifier := range cfg.ResolvedNotifiers(cacheDir) {
+			if notifier.Name() != sinkName {
+				continue
+			}
+			if err := notifier.Notify(syntheticChanges()); err != nil {
+				return fmt.Errorf("notify test failed: %w", err)
+			}
+			fmt.Printf("Sent a synthetic change through %q.\n", sinkName)
+			return nil
+		}
+
+		return fmt.Errorf("no configured notifier sink named %q", sinkName)
+	},
+}
+
+func init() {
+	notifyCmd.AddCommand(notifyTestCmd)
+}
+
+// syntheticChanges builds a fake Changes event for exercising a notifier sink
+// end-to-end without waiting for a real upstream change.
+func syntheticChanges() notify.Changes {
+	return notify.Changes{
+		Provider: "test",
+		NewPrograms: []providers.NormalizedProgram{{
+			Platform:       "test",
+			Handle:         "synthetic-program",
+			Name:           "Synthetic Test Program",
+			URL:            "https://example.com",
+			OffersBounties: true,
+			InScope: []providers.NormalizedScope{{
+				AssetIdentifier: "*.example.com",
+				AssetType:       "WILDCARD",
+			}},
+		}},
+	}
+}
@@ -0,0 +1,136 @@
+// Package config loads the single YAML document every bounty-monitor CLI
+// subcommand reads via --config: which providers to poll, credentials for each
+// enabled notifier sink, and the silence rules to apply before dispatch.
+package config
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+
+	"gopkg.in/yaml.v3"
+
+	"github.com/admiralhr99/bountyMonitor/notify"
+	"github.com/admiralhr99/bountyMonitor/providers"
+)
+
+// Config is the shared --config document.
+type Config struct {
+	Providers []string             `yaml:"providers"`
+	Notifiers NotifiersConfig      `yaml:"notifiers"`
+	Silence   []notify.SilenceRule `yaml:"silence_rules"`
+}
+
+// NotifiersConfig holds per-sink settings. A nil entry means that sink is disabled.
+type NotifiersConfig struct {
+	File     *FileNotifierConfig     `yaml:"file"`
+	Slack    *SlackNotifierConfig    `yaml:"slack"`
+	Discord  *DiscordNotifierConfig  `yaml:"discord"`
+	Telegram *TelegramNotifierConfig `yaml:"telegram"`
+	Webhook  *WebhookNotifierConfig  `yaml:"webhook"`
+	SMTP     *SMTPNotifierConfig     `yaml:"smtp"`
+}
+
+type FileNotifierConfig struct {
+	Path string `yaml:"path"`
+}
+
+type SlackNotifierConfig struct {
+	WebhookURL string `yaml:"webhook_url"`
+}
+
+type DiscordNotifierConfig struct {
+	WebhookURL string `yaml:"webhook_url"`
+}
+
+type TelegramNotifierConfig struct {
+	BotToken string `yaml:"bot_token"`
+	ChatID   string `yaml:"chat_id"`
+}
+
+type WebhookNotifierConfig struct {
+	URL string `yaml:"url"`
+}
+
+type SMTPNotifierConfig struct {
+	Host     string   `yaml:"host"`
+	Port     string   `yaml:"port"`
+	Username string   `yaml:"username"`
+	Password string   `yaml:"password"`
+	From     string   `yaml:"from"`
+	To       []string `yaml:"to"`
+}
+
+// Load reads and parses a bounty-monitor YAML config file.
+func Load(path string) (*Config, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("config: failed to read %s: %w", path, err)
+	}
+
+	var cfg Config
+	if err := yaml.Unmarshal(data, &cfg); err != nil {
+		return nil, fmt.Errorf("config: failed to parse %s: %w", path, err)
+	}
+
+	return &cfg, nil
+}
+
+// ResolvedProviders resolves the configured provider names to Provider
+// implementations. An empty list enables every known provider.
+func (c *Config) ResolvedProviders() ([]providers.Provider, error) {
+	if len(c.Providers) == 0 {
+		return providers.All(), nil
+	}
+
+	resolved := make([]providers.Provider, 0, len(c.Providers))
+	for _, name := range c.Providers {
+		p, ok := providers.ByName(name)
+		if !ok {
+			return nil, fmt.Errorf("config: unknown provider %q", name)
+		}
+		resolved = append(resolved, p)
+	}
+	return resolved, nil
+}
+
+// ResolvedNotifiers builds the configured Notifier sinks. If none are
+// configured, a single file notifier under cacheDir is used so the tool keeps
+// working with no config at all.
+func (c *Config) ResolvedNotifiers(cacheDir string) []notify.Notifier {
+	var sinks []notify.Notifier
+
+	if f := c.Notifiers.File; f != nil {
+		path := f.Path
+		if path == "" {
+			path = filepath.Join(cacheDir, "notifications.txt")
+		}
+		sinks = append(sinks, notify.NewFileNotifier(path))
+	}
+	if s := c.Notifiers.Slack; s != nil {
+		sinks = append(sinks, notify.NewSlackNotifier(s.WebhookURL))
+	}
+	if d := c.Notifiers.Discord; d != nil {
+		sinks = append(sinks, notify.NewDiscordNotifier(d.WebhookURL))
+	}
+	if t := c.Notifiers.Telegram; t != nil {
+		sinks = append(sinks, notify.NewTelegramNotifier(t.BotToken, t.ChatID))
+	}
+	if w := c.Notifiers.Webhook; w != nil {
+		sinks = append(sinks, notify.NewWebhookNotifier(w.URL))
+	}
+	if s := c.Notifiers.SMTP; s != nil {
+		sinks = append(sinks, notify.NewSMTPNotifier(s.Host, s.Port, s.Username, s.Password, s.From, s.To))
+	}
+
+	if len(sinks) == 0 {
+		sinks = append(sinks, notify.NewFileNotifier(filepath.Join(cacheDir, "notifications.txt")))
+	}
+
+	return sinks
+}
+
+// Silencer compiles the config's silence rules into a notify.Silencer.
+func (c *Config) Silencer() (*notify.Silencer, error) {
+	return notify.CompileRules(c.Silence)
+}
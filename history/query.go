@@ -0,0 +1,114 @@
+package history
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/admiralhr99/bountyMonitor/providers"
+)
+
+// Event is one recorded row from the events table.
+type Event struct {
+	TS            time.Time
+	Kind          string
+	ProgramHandle string
+	PayloadJSON   string
+}
+
+// Since returns every event recorded at or after cutoff, newest first.
+func (s *Store) Since(cutoff time.Time) ([]Event, error) {
+	return s.queryEvents(
+		"SELECT ts, kind, program_handle, payload_json FROM events WHERE ts >= ? ORDER BY ts DESC",
+		cutoff,
+	)
+}
+
+// ByProgram returns every event recorded for the given program handle, newest first.
+func (s *Store) ByProgram(handle string) ([]Event, error) {
+	return s.queryEvents(
+		"SELECT ts, kind, program_handle, payload_json FROM events WHERE program_handle = ? ORDER BY ts DESC",
+		handle,
+	)
+}
+
+// ByAsset returns every event recorded against the given asset, newest first.
+// assetType is matched case-insensitively since providers.NormalizedScope.AssetType
+// is always upper-case (see providers.IsRelevantAssetType) but callers -- CLI
+// users especially -- won't reliably type it that way.
+//
+// A plain program_handle JOIN against scopes would only narrow by which
+// program the asset belongs to, not which event actually concerns it -- it'd
+// also surface that program's unrelated new_program/new_scope events for
+// every other asset it has in scope. So candidates are first narrowed that
+// way, then each candidate's own payload is checked to confirm it's actually
+// about this asset.
+func (s *Store) ByAsset(assetType, identifier string) ([]Event, error) {
+	assetType = strings.ToUpper(assetType)
+
+	candidates, err := s.queryEvents(
+		`SELECT DISTINCT e.ts, e.kind, e.program_handle, e.payload_json
+		 FROM events e
+		 JOIN scopes sc ON sc.program_handle = e.program_handle
+		 WHERE sc.asset_type = ? AND sc.identifier = ?
+		 ORDER BY e.ts DESC`,
+		assetType, identifier,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	events := make([]Event, 0, len(candidates))
+	for _, e := range candidates {
+		if eventConcernsAsset(e, assetType, identifier) {
+			events = append(events, e)
+		}
+	}
+	return events, nil
+}
+
+// eventConcernsAsset reports whether e's own payload references the given
+// asset, as opposed to e merely belonging to a program that happens to have
+// it in scope.
+func eventConcernsAsset(e Event, assetType, identifier string) bool {
+	switch e.Kind {
+	case "new_scope", "removed_scope":
+		var scope providers.NormalizedScope
+		if err := json.Unmarshal([]byte(e.PayloadJSON), &scope); err != nil {
+			return false
+		}
+		return strings.EqualFold(scope.AssetType, assetType) && scope.AssetIdentifier == identifier
+	case "new_program":
+		var program providers.NormalizedProgram
+		if err := json.Unmarshal([]byte(e.PayloadJSON), &program); err != nil {
+			return false
+		}
+		for _, scope := range program.InScope {
+			if strings.EqualFold(scope.AssetType, assetType) && scope.AssetIdentifier == identifier {
+				return true
+			}
+		}
+		return false
+	default:
+		return false
+	}
+}
+
+func (s *Store) queryEvents(query string, args ...interface{}) ([]Event, error) {
+	rows, err := s.db.Query(query, args...)
+	if err != nil {
+		return nil, fmt.Errorf("history: query failed: %w", err)
+	}
+	defer rows.Close()
+
+	var events []Event
+	for rows.Next() {
+		var e Event
+		if err := rows.Scan(&e.TS, &e.Kind, &e.ProgramHandle, &e.PayloadJSON); err != nil {
+			return nil, fmt.Errorf("history: failed to scan event: %w", err)
+		}
+		events = append(events, e)
+	}
+	return events, rows.Err()
+}
@@ -0,0 +1,121 @@
+package history
+
+import (
+	"database/sql"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/admiralhr99/bountyMonitor/notify"
+	"github.com/admiralhr99/bountyMonitor/providers"
+)
+
+// RemovedScope is a previously in-scope target that's absent from a provider's
+// current fetch, for recording a scope removal event.
+type RemovedScope struct {
+	ProgramHandle string
+	Scope         providers.NormalizedScope
+}
+
+// RecordCheck persists one provider check's worth of changes -- new programs,
+// new scopes, and removed scopes -- to the programs/scopes/events tables in a
+// single transaction. Callers run it alongside their own cache update so the
+// two stay in sync with what was actually observed.
+func (s *Store) RecordCheck(newPrograms []providers.NormalizedProgram, newScopes []notify.ProgramScopes, removed []RemovedScope, now time.Time) error {
+	tx, err := s.db.Begin()
+	if err != nil {
+		return fmt.Errorf("history: failed to begin transaction: %w", err)
+	}
+	defer tx.Rollback()
+
+	for _, program := range newPrograms {
+		if err := upsertProgram(tx, program, now); err != nil {
+			return fmt.Errorf("history: failed to record program %s: %w", program.Handle, err)
+		}
+		for _, scope := range program.InScope {
+			if !providers.IsRelevantAssetType(scope.AssetType) {
+				continue
+			}
+			if err := insertScope(tx, program.Handle, scope, now); err != nil {
+				return fmt.Errorf("history: failed to record scope for %s: %w", program.Handle, err)
+			}
+		}
+		if err := insertEvent(tx, "new_program", program.Handle, program, now); err != nil {
+			return fmt.Errorf("history: failed to record event for %s: %w", program.Handle, err)
+		}
+	}
+
+	for _, entry := range newScopes {
+		if err := upsertProgram(tx, entry.Program, now); err != nil {
+			return fmt.Errorf("history: failed to record program %s: %w", entry.Program.Handle, err)
+		}
+		for _, scope := range entry.Scopes {
+			if err := insertScope(tx, entry.Program.Handle, scope, now); err != nil {
+				return fmt.Errorf("history: failed to record scope for %s: %w", entry.Program.Handle, err)
+			}
+			if err := insertEvent(tx, "new_scope", entry.Program.Handle, scope, now); err != nil {
+				return fmt.Errorf("history: failed to record event for %s: %w", entry.Program.Handle, err)
+			}
+		}
+	}
+
+	for _, r := range removed {
+		if err := removeScope(tx, r.ProgramHandle, r.Scope, now); err != nil {
+			return fmt.Errorf("history: failed to record scope removal for %s: %w", r.ProgramHandle, err)
+		}
+		if err := insertEvent(tx, "removed_scope", r.ProgramHandle, r.Scope, now); err != nil {
+			return fmt.Errorf("history: failed to record event for %s: %w", r.ProgramHandle, err)
+		}
+	}
+
+	return tx.Commit()
+}
+
+// upsertProgram records program as seen, preserving its first_seen if it was
+// already on record.
+func upsertProgram(tx *sql.Tx, program providers.NormalizedProgram, now time.Time) error {
+	_, err := tx.Exec(
+		`INSERT INTO programs (handle, name, platform, first_seen, last_seen)
+		 VALUES (?, ?, ?, ?, ?)
+		 ON CONFLICT(handle) DO UPDATE SET name = excluded.name, last_seen = excluded.last_seen`,
+		program.Handle, program.Name, program.Platform, now, now,
+	)
+	return err
+}
+
+func insertScope(tx *sql.Tx, handle string, scope providers.NormalizedScope, now time.Time) error {
+	_, err := tx.Exec(
+		`INSERT INTO scopes (program_handle, asset_type, identifier, added_at, max_severity, eligible_for_bounty)
+		 VALUES (?, ?, ?, ?, ?, ?)`,
+		handle, scope.AssetType, scope.AssetIdentifier, now, scope.MaxSeverity, scope.EligibleForBounty,
+	)
+	return err
+}
+
+// removeScope marks the most recent still-open record of handle's scope as
+// removed as of now.
+func removeScope(tx *sql.Tx, handle string, scope providers.NormalizedScope, now time.Time) error {
+	_, err := tx.Exec(
+		`UPDATE scopes SET removed_at = ?
+		 WHERE id = (
+		     SELECT id FROM scopes
+		     WHERE program_handle = ? AND asset_type = ? AND identifier = ? AND removed_at IS NULL
+		     ORDER BY added_at DESC LIMIT 1
+		 )`,
+		now, handle, scope.AssetType, scope.AssetIdentifier,
+	)
+	return err
+}
+
+func insertEvent(tx *sql.Tx, kind, handle string, payload interface{}, now time.Time) error {
+	data, err := json.Marshal(payload)
+	if err != nil {
+		return err
+	}
+
+	_, err = tx.Exec(
+		`INSERT INTO events (ts, kind, program_handle, payload_json) VALUES (?, ?, ?, ?)`,
+		now, kind, handle, string(data),
+	)
+	return err
+}
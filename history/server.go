@@ -0,0 +1,58 @@
+package history
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+	"time"
+)
+
+// Serve starts a tiny read-only JSON API over the store's query methods:
+//
+//	GET /since?d=<duration>              -- Store.Since
+//	GET /program/<handle>                -- Store.ByProgram
+//	GET /asset/<type>/<identifier>       -- Store.ByAsset
+//
+// It blocks serving on addr until the listener errors, mirroring
+// http.ListenAndServe.
+func (s *Store) Serve(addr string) error {
+	mux := http.NewServeMux()
+
+	mux.HandleFunc("/since", func(w http.ResponseWriter, r *http.Request) {
+		d, err := time.ParseDuration(r.URL.Query().Get("d"))
+		if err != nil {
+			http.Error(w, fmt.Sprintf("invalid d: %v", err), http.StatusBadRequest)
+			return
+		}
+		events, err := s.Since(time.Now().Add(-d))
+		writeJSONEvents(w, events, err)
+	})
+
+	mux.HandleFunc("/program/", func(w http.ResponseWriter, r *http.Request) {
+		handle := strings.TrimPrefix(r.URL.Path, "/program/")
+		events, err := s.ByProgram(handle)
+		writeJSONEvents(w, events, err)
+	})
+
+	mux.HandleFunc("/asset/", func(w http.ResponseWriter, r *http.Request) {
+		assetType, identifier, ok := strings.Cut(strings.TrimPrefix(r.URL.Path, "/asset/"), "/")
+		if !ok {
+			http.Error(w, "expected /asset/<type>/<identifier>", http.StatusBadRequest)
+			return
+		}
+		events, err := s.ByAsset(assetType, identifier)
+		writeJSONEvents(w, events, err)
+	})
+
+	return http.ListenAndServe(addr, mux)
+}
+
+func writeJSONEvents(w http.ResponseWriter, events []Event, err error) {
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(events)
+}
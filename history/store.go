@@ -0,0 +1,72 @@
+// Package history persists every program/scope change bounty-monitor observes
+// to a queryable SQLite database (via modernc.org/sqlite, which is cgo-free so
+// it doesn't complicate cross-compiling the rest of the tool), instead of only
+// the free-form text a Notifier renders. It answers questions like "when did
+// this scope first appear?" that a rendered-text notification can't.
+package history
+
+import (
+	"database/sql"
+	"fmt"
+
+	_ "modernc.org/sqlite"
+)
+
+const schema = `
+CREATE TABLE IF NOT EXISTS programs (
+	handle     TEXT PRIMARY KEY,
+	name       TEXT NOT NULL,
+	platform   TEXT NOT NULL,
+	first_seen DATETIME NOT NULL,
+	last_seen  DATETIME NOT NULL
+);
+
+CREATE TABLE IF NOT EXISTS scopes (
+	id                  INTEGER PRIMARY KEY AUTOINCREMENT,
+	program_handle      TEXT NOT NULL,
+	asset_type          TEXT NOT NULL,
+	identifier          TEXT NOT NULL,
+	added_at            DATETIME NOT NULL,
+	removed_at          DATETIME,
+	max_severity        TEXT,
+	eligible_for_bounty BOOLEAN NOT NULL
+);
+CREATE INDEX IF NOT EXISTS idx_scopes_program_handle ON scopes(program_handle);
+CREATE INDEX IF NOT EXISTS idx_scopes_identifier ON scopes(asset_type, identifier);
+
+CREATE TABLE IF NOT EXISTS events (
+	id             INTEGER PRIMARY KEY AUTOINCREMENT,
+	ts             DATETIME NOT NULL,
+	kind           TEXT NOT NULL,
+	program_handle TEXT NOT NULL,
+	payload_json   TEXT NOT NULL
+);
+CREATE INDEX IF NOT EXISTS idx_events_ts ON events(ts);
+CREATE INDEX IF NOT EXISTS idx_events_program_handle ON events(program_handle);
+`
+
+// Store is a SQLite-backed history of every program/scope change bounty-monitor
+// has recorded.
+type Store struct {
+	db *sql.DB
+}
+
+// Open opens (creating and migrating if necessary) a history store at path.
+func Open(path string) (*Store, error) {
+	db, err := sql.Open("sqlite", path)
+	if err != nil {
+		return nil, fmt.Errorf("history: failed to open %s: %w", path, err)
+	}
+
+	if _, err := db.Exec(schema); err != nil {
+		db.Close()
+		return nil, fmt.Errorf("history: failed to migrate schema: %w", err)
+	}
+
+	return &Store{db: db}, nil
+}
+
+// Close closes the underlying database.
+func (s *Store) Close() error {
+	return s.db.Close()
+}
@@ -0,0 +1,440 @@
+package main
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"log"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/admiralhr99/bountyMonitor/cache"
+	"github.com/admiralhr99/bountyMonitor/config"
+	"github.com/admiralhr99/bountyMonitor/history"
+	"github.com/admiralhr99/bountyMonitor/notify"
+	"github.com/admiralhr99/bountyMonitor/providers"
+	"github.com/admiralhr99/bountyMonitor/scopeindex"
+)
+
+const (
+	cacheDir      = ".bounty-monitor"
+	checkInterval = 1 * time.Hour
+
+	// maxAbsenceBeforeRemoval is how many consecutive checks a previously-seen
+	// program can be missing from an upstream feed before it's treated as
+	// actually removed. This absorbs the upstream feed's occasional blips
+	// without generating a spurious "new program" event when it comes back.
+	maxAbsenceBeforeRemoval = 3
+
+	// Maximum number of programs to process in a single batch
+	// This helps manage memory usage for large files
+	batchSize = 250
+)
+
+// runCheck fetches current data from every provider cfg enables, compares each
+// against its own cache, and dispatches provider-tagged notifications through
+// cfg's configured sinks, after cfg's silence rules have been applied.
+func runCheck(cfg *config.Config) error {
+	enabled, err := cfg.ResolvedProviders()
+	if err != nil {
+		return err
+	}
+
+	notifiers := cfg.ResolvedNotifiers(cacheDir)
+
+	silencer, err := cfg.Silencer()
+	if err != nil {
+		return fmt.Errorf("failed to compile silence rules: %w", err)
+	}
+
+	historyStore, err := history.Open(filepath.Join(cacheDir, "history.db"))
+	if err != nil {
+		return fmt.Errorf("failed to open history store: %w", err)
+	}
+	defer historyStore.Close()
+
+	var firstErr error
+	for _, provider := range enabled {
+		if err := runProviderCheck(provider, notifiers, silencer, historyStore); err != nil {
+			log.Printf("[%s] check failed: %v", provider.Name(), err)
+			if firstErr == nil {
+				firstErr = fmt.Errorf("%s: %w", provider.Name(), err)
+			}
+		}
+	}
+
+	return firstErr
+}
+
+// runProviderCheck fetches, diffs, and notifies for a single provider. A
+// provider whose feed is large enough to need streaming (see
+// providers.StreamingDiffer) is diffed incrementally against a scopeindex
+// instead of going through the full-snapshot cache path below.
+func runProviderCheck(provider providers.Provider, notifiers []notify.Notifier, silencer *notify.Silencer, historyStore *history.Store) error {
+	if streaming, ok := provider.(providers.StreamingDiffer); ok {
+		return runStreamingProviderCheck(provider, streaming, notifiers, silencer, historyStore)
+	}
+
+	currentData, err := provider.Fetch()
+	if err != nil {
+		return fmt.Errorf("failed to fetch data: %v", err)
+	}
+
+	providerCache, err := cache.Open(filepath.Join(cacheDir, provider.CacheKey()))
+	if err != nil {
+		return fmt.Errorf("failed to open cache: %v", err)
+	}
+
+	snapshot, err := providerCache.Load()
+	if err != nil {
+		if os.IsNotExist(err) {
+			log.Printf("[%s] No previous data found. This appears to be the first run.", provider.Name())
+			log.Printf("[%s] Saving current data for future comparison.", provider.Name())
+			if err := historyStore.RecordCheck(currentData, nil, nil, time.Now()); err != nil {
+				log.Printf("[%s] failed to record history: %v", provider.Name(), err)
+			}
+			if err := providerCache.Save(mergeSnapshot(cache.Snapshot{}, currentData)); err != nil {
+				return fmt.Errorf("failed to save current data: %v", err)
+			}
+			return nil
+		}
+		return fmt.Errorf("failed to load previous data: %v", err)
+	}
+
+	previousData := snapshotPrograms(snapshot)
+	changes := findChanges(provider.Name(), previousData, currentData)
+	removedScopes := findRemovedScopes(snapshot, currentData)
+
+	if err := historyStore.RecordCheck(changes.NewPrograms, changes.NewScopes, removedScopes, time.Now()); err != nil {
+		log.Printf("[%s] failed to record history: %v", provider.Name(), err)
+	}
+
+	if !changes.IsEmpty() {
+		filtered, silencedCount := silencer.Filter(changes)
+		if silencedCount > 0 {
+			log.Printf("[%s] silenced %d events", provider.Name(), silencedCount)
+		}
+
+		if !filtered.IsEmpty() {
+			for _, notifier := range notifiers {
+				if err := notifier.Notify(filtered); err != nil {
+					log.Printf("[%s] notifier %s failed: %v", provider.Name(), notifier.Name(), err)
+				}
+			}
+		}
+	} else {
+		log.Printf("[%s] No changes detected", provider.Name())
+	}
+
+	if err := providerCache.Save(mergeSnapshot(snapshot, currentData)); err != nil {
+		return fmt.Errorf("failed to save current data: %v", err)
+	}
+
+	return nil
+}
+
+// runStreamingProviderCheck is runProviderCheck's path for a StreamingDiffer:
+// instead of loading a full previous snapshot and diffing it against a full
+// current fetch, it streams the provider's feed program-by-program against a
+// scopeindex.Index, accumulating only the new programs, new scopes and removed
+// scopes emitted along the way. It still maintains a cache.Snapshot alongside the scope
+// index, keyed the same way the non-streaming path does, so `list` and
+// `export` can see this provider's programs too -- only a touched program's
+// record is refreshed on any given run, since that's all FetchAndDiff emits,
+// but that's enough to keep the snapshot converging on current state.
+//
+// Like runProviderCheck, a first run (no prior cache) persists the seeded
+// snapshot and history without notifying: FetchAndDiff reports every program
+// as IsNew when the scope index starts out empty, and dispatching that whole
+// seed as "new program" notifications would flood every configured sink.
+func runStreamingProviderCheck(provider providers.Provider, streaming providers.StreamingDiffer, notifiers []notify.Notifier, silencer *notify.Silencer, historyStore *history.Store) error {
+	idx, err := scopeindex.Open(filepath.Join(cacheDir, provider.Name()+".bolt"))
+	if err != nil {
+		return fmt.Errorf("failed to open scope index: %w", err)
+	}
+	defer idx.Close()
+
+	providerCache, err := cache.Open(filepath.Join(cacheDir, provider.CacheKey()))
+	if err != nil {
+		return fmt.Errorf("failed to open cache: %w", err)
+	}
+
+	snapshot, err := providerCache.Load()
+	firstRun := false
+	if err != nil {
+		if !os.IsNotExist(err) {
+			return fmt.Errorf("failed to load previous data: %w", err)
+		}
+		firstRun = true
+	}
+	if snapshot.Programs == nil {
+		snapshot.Programs = make(map[string]cache.ProgramRecord)
+	}
+
+	changes := notify.Changes{Provider: provider.Name()}
+	newScopesByHandle := make(map[string]*notify.ProgramScopes)
+	var removedScopes []history.RemovedScope
+
+	now := time.Now()
+	err = streaming.FetchAndDiff(idx, batchSize, func(diff providers.ProgramDiff) {
+		record := snapshot.Programs[diff.Program.Handle]
+		if record.FirstSeen.IsZero() {
+			record.FirstSeen = now
+		}
+		record.Program = diff.Program
+		record.LastSeen = now
+		record.FailureCount = 0
+		snapshot.Programs[diff.Program.Handle] = record
+
+		if diff.IsNew {
+			changes.NewPrograms = append(changes.NewPrograms, diff.Program)
+			return
+		}
+		if len(diff.NewScopes) > 0 {
+			entry, ok := newScopesByHandle[diff.Program.Handle]
+			if !ok {
+				entry = &notify.ProgramScopes{Program: diff.Program}
+				newScopesByHandle[diff.Program.Handle] = entry
+			}
+			entry.Scopes = append(entry.Scopes, diff.NewScopes...)
+		}
+		for _, scope := range diff.RemovedScopes {
+			removedScopes = append(removedScopes, history.RemovedScope{ProgramHandle: diff.Program.Handle, Scope: scope})
+		}
+	})
+	if err != nil {
+		return fmt.Errorf("failed to stream and diff: %w", err)
+	}
+
+	if err := providerCache.Save(snapshot); err != nil {
+		return fmt.Errorf("failed to save current data: %w", err)
+	}
+
+	for _, entry := range newScopesByHandle {
+		changes.NewScopes = append(changes.NewScopes, *entry)
+	}
+
+	if err := historyStore.RecordCheck(changes.NewPrograms, changes.NewScopes, removedScopes, time.Now()); err != nil {
+		log.Printf("[%s] failed to record history: %v", provider.Name(), err)
+	}
+
+	if firstRun {
+		log.Printf("[%s] No previous data found. This appears to be the first run.", provider.Name())
+		log.Printf("[%s] Saving current data for future comparison.", provider.Name())
+		return nil
+	}
+
+	if changes.IsEmpty() {
+		log.Printf("[%s] No changes detected", provider.Name())
+		return nil
+	}
+
+	filtered, silencedCount := silencer.Filter(changes)
+	if silencedCount > 0 {
+		log.Printf("[%s] silenced %d events", provider.Name(), silencedCount)
+	}
+
+	if !filtered.IsEmpty() {
+		for _, notifier := range notifiers {
+			if err := notifier.Notify(filtered); err != nil {
+				log.Printf("[%s] notifier %s failed: %v", provider.Name(), notifier.Name(), err)
+			}
+		}
+	}
+
+	return nil
+}
+
+// snapshotPrograms extracts the programs a snapshot has on record, including
+// ones currently within their absence grace period, for use as findChanges'
+// "previous" side.
+func snapshotPrograms(snapshot cache.Snapshot) []providers.NormalizedProgram {
+	programs := make([]providers.NormalizedProgram, 0, len(snapshot.Programs))
+	for _, record := range snapshot.Programs {
+		programs = append(programs, record.Program)
+	}
+	return programs
+}
+
+// mergeSnapshot folds a fresh fetch into the previous snapshot: programs seen
+// this run have their record refreshed and failure count reset, while programs
+// that didn't show up get their failure count bumped and are only dropped once
+// they've been missing for more than maxAbsenceBeforeRemoval consecutive checks.
+func mergeSnapshot(previous cache.Snapshot, current []providers.NormalizedProgram) cache.Snapshot {
+	now := time.Now()
+	next := cache.Snapshot{Programs: make(map[string]cache.ProgramRecord, len(previous.Programs))}
+
+	seen := make(map[string]bool, len(current))
+	for _, program := range current {
+		seen[program.Handle] = true
+
+		record := previous.Programs[program.Handle]
+		if record.FirstSeen.IsZero() {
+			record.FirstSeen = now
+		}
+		record.Program = program
+		record.LastSeen = now
+		record.FailureCount = 0
+		record.LastCheckedHash = scopeHash(program)
+		next.Programs[program.Handle] = record
+	}
+
+	for handle, record := range previous.Programs {
+		if seen[handle] {
+			continue
+		}
+		record.FailureCount++
+		if record.FailureCount > maxAbsenceBeforeRemoval {
+			continue
+		}
+		next.Programs[handle] = record
+	}
+
+	return next
+}
+
+// scopeHash fingerprints a program's in-scope targets so a cheap hash
+// comparison can short-circuit a full scope diff in the future.
+func scopeHash(program providers.NormalizedProgram) string {
+	keys := make([]string, 0, len(program.InScope))
+	for _, scope := range program.InScope {
+		keys = append(keys, scope.AssetType+":"+scope.AssetIdentifier)
+	}
+	sort.Strings(keys)
+
+	sum := sha256.Sum256([]byte(strings.Join(keys, "\n")))
+	return hex.EncodeToString(sum[:])
+}
+
+// findChanges identifies new programs and new in-scope targets for a provider
+func findChanges(providerName string, previous, current []providers.NormalizedProgram) notify.Changes {
+	changes := notify.Changes{Provider: providerName}
+	newScopesByHandle := make(map[string]*notify.ProgramScopes)
+
+	// Create map of previous program handles
+	previousPrograms := make(map[string]bool)
+	for _, program := range previous {
+		previousPrograms[program.Handle] = true
+	}
+
+	// Create a map for previous program scopes
+	previousScopes := make(map[string]map[string]bool)
+
+	for _, program := range previous {
+		// Skip paused or disabled programs
+		if program.SubmissionState != "open" {
+			continue
+		}
+
+		previousScopes[program.Handle] = make(map[string]bool)
+		for _, scope := range program.InScope {
+			// Only record relevant asset types (URL, WILDCARD, CIDR, IP_ADDRESS, API)
+			if providers.IsRelevantAssetType(scope.AssetType) {
+				key := scope.AssetType + ":" + scope.AssetIdentifier
+				previousScopes[program.Handle][key] = true
+			}
+		}
+	}
+
+	// Find new programs and new scopes
+	for _, program := range current {
+		// Skip paused or disabled programs
+		if program.SubmissionState != "open" {
+			continue
+		}
+
+		// Check if this is a new program
+		if !previousPrograms[program.Handle] {
+			changes.NewPrograms = append(changes.NewPrograms, program)
+			continue
+		}
+
+		// Check for new scopes
+		for _, scope := range program.InScope {
+			// Only check relevant asset types (URL, WILDCARD, CIDR, IP_ADDRESS, API)
+			if providers.IsRelevantAssetType(scope.AssetType) {
+				key := scope.AssetType + ":" + scope.AssetIdentifier
+
+				// Check if this scope is new
+				if prevProgram, exists := previousScopes[program.Handle]; !exists || !prevProgram[key] {
+					entry, ok := newScopesByHandle[program.Handle]
+					if !ok {
+						entry = &notify.ProgramScopes{Program: program}
+						newScopesByHandle[program.Handle] = entry
+					}
+					entry.Scopes = append(entry.Scopes, scope)
+				}
+			}
+		}
+	}
+
+	for _, entry := range newScopesByHandle {
+		changes.NewScopes = append(changes.NewScopes, *entry)
+	}
+
+	return changes
+}
+
+// findRemovedScopes identifies previously in-scope targets that are absent
+// from the current fetch, for recording scope removal events to history.
+// Unlike findChanges, it isn't filtered by notify's silence rules -- scope
+// removal isn't notified on today, only recorded.
+//
+// A program that's merely within mergeSnapshot's absence grace window is
+// skipped entirely rather than having all of its scopes reported removed:
+// mergeSnapshot keeps such a program on record (and findChanges' "previous"
+// side along with it) specifically so a blip doesn't read as a real change,
+// so recording every one of its scopes as removed on each of those checks --
+// and again once it's actually dropped -- would contradict that and leave
+// removed_at set if it later reappears. A scope dropped from a program that's
+// still present in current isn't covered by that grace window and is still
+// reported immediately.
+func findRemovedScopes(previous cache.Snapshot, current []providers.NormalizedProgram) []history.RemovedScope {
+	currentPrograms := make(map[string]bool, len(current))
+	currentKeys := make(map[string]map[string]bool, len(current))
+	for _, program := range current {
+		currentPrograms[program.Handle] = true
+		keys := make(map[string]bool, len(program.InScope))
+		for _, scope := range program.InScope {
+			if providers.IsRelevantAssetType(scope.AssetType) {
+				keys[scope.AssetType+":"+scope.AssetIdentifier] = true
+			}
+		}
+		currentKeys[program.Handle] = keys
+	}
+
+	var removed []history.RemovedScope
+	for handle, record := range previous.Programs {
+		program := record.Program
+		if program.SubmissionState != "open" {
+			continue
+		}
+		if !currentPrograms[handle] && record.FailureCount < maxAbsenceBeforeRemoval {
+			continue
+		}
+
+		keys := currentKeys[handle]
+		for _, scope := range program.InScope {
+			if !providers.IsRelevantAssetType(scope.AssetType) {
+				continue
+			}
+			key := scope.AssetType + ":" + scope.AssetIdentifier
+			if !keys[key] {
+				removed = append(removed, history.RemovedScope{ProgramHandle: handle, Scope: scope})
+			}
+		}
+	}
+	return removed
+}
+
+// ensureCacheDir ensures the cache directory exists
+func ensureCacheDir() error {
+	if _, err := os.Stat(cacheDir); os.IsNotExist(err) {
+		log.Printf("Creating cache directory: %s", cacheDir)
+		return os.MkdirAll(cacheDir, 0755)
+	}
+	return nil
+}
@@ -0,0 +1,52 @@
+package notify
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"time"
+)
+
+// discordMessageLimit is Discord's hard cap on a single message's content length.
+const discordMessageLimit = 2000
+
+// DiscordNotifier posts a rendered Changes event to a Discord webhook.
+type DiscordNotifier struct {
+	WebhookURL string
+	Client     *http.Client
+}
+
+// NewDiscordNotifier returns a Notifier that posts to a Discord webhook URL.
+func NewDiscordNotifier(webhookURL string) *DiscordNotifier {
+	return &DiscordNotifier{WebhookURL: webhookURL, Client: &http.Client{Timeout: 10 * time.Second}}
+}
+
+func (n *DiscordNotifier) Name() string { return "discord" }
+
+func (n *DiscordNotifier) Notify(changes Changes) error {
+	content := renderText(changes)
+	if len(content) > discordMessageLimit {
+		content = content[:discordMessageLimit-len("...(truncated)")] + "...(truncated)"
+	}
+
+	payload := struct {
+		Content string `json:"content"`
+	}{Content: content}
+
+	body, err := json.Marshal(payload)
+	if err != nil {
+		return fmt.Errorf("failed to marshal discord payload: %w", err)
+	}
+
+	resp, err := n.Client.Post(n.WebhookURL, "application/json", bytes.NewReader(body))
+	if err != nil {
+		return fmt.Errorf("failed to post to discord: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode >= 300 {
+		return fmt.Errorf("discord webhook returned unexpected status code: %d", resp.StatusCode)
+	}
+	return nil
+}
@@ -0,0 +1,32 @@
+package notify
+
+import (
+	"fmt"
+	"os"
+	"time"
+)
+
+// FileNotifier appends each Changes event, rendered as plain text, to a local
+// file. It's the direct successor of the original notifications.txt behavior.
+type FileNotifier struct {
+	Path string
+}
+
+// NewFileNotifier returns a Notifier that appends rendered events to path.
+func NewFileNotifier(path string) *FileNotifier {
+	return &FileNotifier{Path: path}
+}
+
+func (n *FileNotifier) Name() string { return "file" }
+
+func (n *FileNotifier) Notify(changes Changes) error {
+	file, err := os.OpenFile(n.Path, os.O_CREATE|os.O_WRONLY|os.O_APPEND, 0644)
+	if err != nil {
+		return fmt.Errorf("failed to open notification file: %w", err)
+	}
+	defer file.Close()
+
+	timestamp := time.Now().Format("2006-01-02 15:04:05")
+	_, err = fmt.Fprintf(file, "[%s]\n%s\n\n", timestamp, renderText(changes))
+	return err
+}
@@ -0,0 +1,34 @@
+// Package notify carries diff results from bounty-monitor's check loop out to
+// whatever sinks the user has configured (a local file, a chat webhook, email...),
+// and applies the silencing rules that keep noisy programs from re-triggering alerts.
+package notify
+
+import "github.com/admiralhr99/bountyMonitor/providers"
+
+// ProgramScopes pairs a program with the new in-scope targets found on it.
+type ProgramScopes struct {
+	Program providers.NormalizedProgram
+	Scopes  []providers.NormalizedScope
+}
+
+// Changes is the structured diff event produced by comparing two provider
+// snapshots. Notifier implementations render it into their own sink-specific
+// format rather than consuming a single pre-formatted string.
+type Changes struct {
+	Provider    string
+	NewPrograms []providers.NormalizedProgram
+	NewScopes   []ProgramScopes
+}
+
+// IsEmpty reports whether the diff found nothing worth notifying about.
+func (c Changes) IsEmpty() bool {
+	return len(c.NewPrograms) == 0 && len(c.NewScopes) == 0
+}
+
+// Notifier dispatches a Changes event to a destination such as a file, a chat
+// webhook, or an inbox. Implementations render the event in their own format;
+// Notify should return an error rather than partially deliver.
+type Notifier interface {
+	Name() string
+	Notify(Changes) error
+}
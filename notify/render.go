@@ -0,0 +1,69 @@
+package notify
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/admiralhr99/bountyMonitor/providers"
+)
+
+// RenderText renders a Changes event as the same human-readable report the
+// original single-file tool used to write to notifications.txt. Exported for
+// callers outside this package (e.g. the `diff` CLI subcommand) that want the
+// same report without going through a Notifier.
+func RenderText(changes Changes) string {
+	return renderText(changes)
+}
+
+// renderText renders a Changes event as the same human-readable report the
+// original single-file tool used to write to notifications.txt. Sinks that want
+// a plain-text body (file, Slack, Discord, Telegram, SMTP) call this instead of each
+// re-implementing their own formatting.
+func renderText(changes Changes) string {
+	var out strings.Builder
+
+	if changes.Provider != "" {
+		out.WriteString(fmt.Sprintf("[%s]\n", changes.Provider))
+	}
+
+	if len(changes.NewPrograms) > 0 {
+		programs := append([]providers.NormalizedProgram(nil), changes.NewPrograms...)
+		sort.Slice(programs, func(i, j int) bool { return programs[i].Name < programs[j].Name })
+
+		out.WriteString(fmt.Sprintf("New programs found: %d\n\n", len(programs)))
+		for _, program := range programs {
+			out.WriteString(fmt.Sprintf("=== NEW PROGRAM: %s (%s) ===\n", program.Name, program.Handle))
+			out.WriteString(fmt.Sprintf("Program URL: %s\n", program.URL))
+			out.WriteString(fmt.Sprintf("Offers Bounties: %t\n\n", program.OffersBounties))
+		}
+	}
+
+	if len(changes.NewScopes) > 0 {
+		entries := append([]ProgramScopes(nil), changes.NewScopes...)
+		sort.Slice(entries, func(i, j int) bool { return entries[i].Program.Name < entries[j].Program.Name })
+
+		out.WriteString(fmt.Sprintf("New scopes found in existing programs: %d\n\n", len(entries)))
+		for _, entry := range entries {
+			out.WriteString(fmt.Sprintf("=== %s (%s) ===\n", entry.Program.Name, entry.Program.Handle))
+			out.WriteString(fmt.Sprintf("Program URL: %s\n", entry.Program.URL))
+
+			scopes := append([]providers.NormalizedScope(nil), entry.Scopes...)
+			sort.Slice(scopes, func(i, j int) bool { return scopes[i].AssetIdentifier < scopes[j].AssetIdentifier })
+
+			for _, scope := range scopes {
+				eligibility := ""
+				if scope.EligibleForBounty {
+					eligibility = " (Eligible for bounty)"
+				}
+				out.WriteString(fmt.Sprintf("- [%s] %s%s\n", scope.AssetType, scope.AssetIdentifier, eligibility))
+				if scope.MaxSeverity != "" {
+					out.WriteString(fmt.Sprintf("  Max Severity: %s\n", scope.MaxSeverity))
+				}
+			}
+			out.WriteString("\n")
+		}
+	}
+
+	return out.String()
+}
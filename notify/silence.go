@@ -0,0 +1,65 @@
+package notify
+
+import (
+	"fmt"
+	"os"
+	"regexp"
+	"time"
+
+	"gopkg.in/yaml.v3"
+)
+
+// SilenceRule suppresses matching notifications until it expires. Modeled on
+// bosun's silence-tester: a rule matches an entry only if every field the rule
+// sets also matches that entry, and an expired rule (Until in the past) never
+// matches at all.
+type SilenceRule struct {
+	Program         string    `yaml:"program"`
+	AssetType       string    `yaml:"asset_type"`
+	IdentifierRegex string    `yaml:"identifier_regex"`
+	Until           time.Time `yaml:"until"`
+
+	identifierRe *regexp.Regexp
+}
+
+// SilenceConfig is the top-level YAML document describing a set of silence rules.
+type SilenceConfig struct {
+	Rules []SilenceRule `yaml:"rules"`
+}
+
+// LoadSilenceConfig reads and compiles a silence rule set from a YAML file. A
+// missing file is not an error: it's treated as "no rules configured".
+func LoadSilenceConfig(path string) (*Silencer, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return NewSilencer(nil), nil
+		}
+		return nil, fmt.Errorf("failed to read silence config %s: %w", path, err)
+	}
+
+	var cfg SilenceConfig
+	if err := yaml.Unmarshal(data, &cfg); err != nil {
+		return nil, fmt.Errorf("failed to parse silence config %s: %w", path, err)
+	}
+
+	return CompileRules(cfg.Rules)
+}
+
+// CompileRules compiles a rule set (e.g. one embedded in a larger shared config
+// document) into a Silencer.
+func CompileRules(rules []SilenceRule) (*Silencer, error) {
+	for i := range rules {
+		rule := &rules[i]
+		if rule.IdentifierRegex == "" {
+			continue
+		}
+		re, err := regexp.Compile(rule.IdentifierRegex)
+		if err != nil {
+			return nil, fmt.Errorf("silence rule %d: invalid identifier_regex %q: %w", i, rule.IdentifierRegex, err)
+		}
+		rule.identifierRe = re
+	}
+
+	return NewSilencer(rules), nil
+}
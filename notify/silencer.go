@@ -0,0 +1,76 @@
+package notify
+
+import "time"
+
+// Silencer tests programs and scope entries against a set of SilenceRules and
+// strips matches out of a Changes event before it reaches any Notifier.
+type Silencer struct {
+	rules []SilenceRule
+}
+
+// NewSilencer builds a Silencer from an already-compiled rule set.
+func NewSilencer(rules []SilenceRule) *Silencer {
+	return &Silencer{rules: rules}
+}
+
+// matches reports whether rule applies to the given program/asset type/identifier,
+// treating an unset rule field as "matches anything" and an expired rule as
+// "matches nothing".
+func (r SilenceRule) matches(program, assetType, identifier string) bool {
+	if !r.Until.IsZero() && time.Now().After(r.Until) {
+		return false
+	}
+	if r.Program != "" && r.Program != program {
+		return false
+	}
+	if r.AssetType != "" && r.AssetType != assetType {
+		return false
+	}
+	if r.identifierRe != nil && !r.identifierRe.MatchString(identifier) {
+		return false
+	}
+	return true
+}
+
+// silenced reports whether any configured rule silences this program/scope entry.
+func (s *Silencer) silenced(program, assetType, identifier string) bool {
+	for _, rule := range s.rules {
+		if rule.matches(program, assetType, identifier) {
+			return true
+		}
+	}
+	return false
+}
+
+// Filter drops new programs and new scope entries that match a silence rule,
+// returning the filtered event and the number of entries it silenced. A
+// silenced program is dropped wholesale; a silenced scope entry is dropped but
+// its program is kept if it still has other, un-silenced scopes to report.
+func (s *Silencer) Filter(changes Changes) (Changes, int) {
+	filtered := Changes{Provider: changes.Provider}
+	silencedCount := 0
+
+	for _, program := range changes.NewPrograms {
+		if s.silenced(program.Handle, "", "") {
+			silencedCount++
+			continue
+		}
+		filtered.NewPrograms = append(filtered.NewPrograms, program)
+	}
+
+	for _, entry := range changes.NewScopes {
+		keptScopes := entry.Scopes[:0:0]
+		for _, scope := range entry.Scopes {
+			if s.silenced(entry.Program.Handle, scope.AssetType, scope.AssetIdentifier) {
+				silencedCount++
+				continue
+			}
+			keptScopes = append(keptScopes, scope)
+		}
+		if len(keptScopes) > 0 {
+			filtered.NewScopes = append(filtered.NewScopes, ProgramScopes{Program: entry.Program, Scopes: keptScopes})
+		}
+	}
+
+	return filtered, silencedCount
+}
@@ -0,0 +1,44 @@
+package notify
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"time"
+)
+
+// SlackNotifier posts a rendered Changes event to a Slack incoming webhook.
+type SlackNotifier struct {
+	WebhookURL string
+	Client     *http.Client
+}
+
+// NewSlackNotifier returns a Notifier that posts to a Slack incoming webhook URL.
+func NewSlackNotifier(webhookURL string) *SlackNotifier {
+	return &SlackNotifier{WebhookURL: webhookURL, Client: &http.Client{Timeout: 10 * time.Second}}
+}
+
+func (n *SlackNotifier) Name() string { return "slack" }
+
+func (n *SlackNotifier) Notify(changes Changes) error {
+	payload := struct {
+		Text string `json:"text"`
+	}{Text: renderText(changes)}
+
+	body, err := json.Marshal(payload)
+	if err != nil {
+		return fmt.Errorf("failed to marshal slack payload: %w", err)
+	}
+
+	resp, err := n.Client.Post(n.WebhookURL, "application/json", bytes.NewReader(body))
+	if err != nil {
+		return fmt.Errorf("failed to post to slack: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode >= 300 {
+		return fmt.Errorf("slack webhook returned unexpected status code: %d", resp.StatusCode)
+	}
+	return nil
+}
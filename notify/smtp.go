@@ -0,0 +1,40 @@
+package notify
+
+import (
+	"fmt"
+	"net/smtp"
+)
+
+// SMTPNotifier emails a rendered Changes event through an SMTP relay.
+type SMTPNotifier struct {
+	Host     string
+	Port     string
+	Username string
+	Password string
+	From     string
+	To       []string
+}
+
+// NewSMTPNotifier returns a Notifier that emails rendered events through an SMTP relay.
+func NewSMTPNotifier(host, port, username, password, from string, to []string) *SMTPNotifier {
+	return &SMTPNotifier{Host: host, Port: port, Username: username, Password: password, From: from, To: to}
+}
+
+func (n *SMTPNotifier) Name() string { return "smtp" }
+
+func (n *SMTPNotifier) Notify(changes Changes) error {
+	subject := "bounty-monitor: new scope changes"
+	if changes.Provider != "" {
+		subject = fmt.Sprintf("bounty-monitor: new scope changes (%s)", changes.Provider)
+	}
+
+	msg := []byte(fmt.Sprintf("Subject: %s\r\n\r\n%s", subject, renderText(changes)))
+
+	auth := smtp.PlainAuth("", n.Username, n.Password, n.Host)
+	addr := fmt.Sprintf("%s:%s", n.Host, n.Port)
+
+	if err := smtp.SendMail(addr, auth, n.From, n.To, msg); err != nil {
+		return fmt.Errorf("failed to send email: %w", err)
+	}
+	return nil
+}
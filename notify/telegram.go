@@ -0,0 +1,41 @@
+package notify
+
+import (
+	"fmt"
+	"net/http"
+	"net/url"
+	"time"
+)
+
+// TelegramNotifier posts a rendered Changes event via a Telegram bot's sendMessage API.
+type TelegramNotifier struct {
+	BotToken string
+	ChatID   string
+	Client   *http.Client
+}
+
+// NewTelegramNotifier returns a Notifier that sends messages through a Telegram bot.
+func NewTelegramNotifier(botToken, chatID string) *TelegramNotifier {
+	return &TelegramNotifier{BotToken: botToken, ChatID: chatID, Client: &http.Client{Timeout: 10 * time.Second}}
+}
+
+func (n *TelegramNotifier) Name() string { return "telegram" }
+
+func (n *TelegramNotifier) Notify(changes Changes) error {
+	apiURL := fmt.Sprintf("https://api.telegram.org/bot%s/sendMessage", n.BotToken)
+
+	form := url.Values{}
+	form.Set("chat_id", n.ChatID)
+	form.Set("text", renderText(changes))
+
+	resp, err := n.Client.PostForm(apiURL, form)
+	if err != nil {
+		return fmt.Errorf("failed to send telegram message: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode >= 300 {
+		return fmt.Errorf("telegram API returned unexpected status code: %d", resp.StatusCode)
+	}
+	return nil
+}
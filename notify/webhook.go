@@ -0,0 +1,42 @@
+package notify
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"time"
+)
+
+// WebhookNotifier POSTs the raw, structured Changes event as JSON to an
+// arbitrary HTTP endpoint, for teams piping bounty-monitor into their own
+// automation rather than a chat app.
+type WebhookNotifier struct {
+	URL    string
+	Client *http.Client
+}
+
+// NewWebhookNotifier returns a Notifier that POSTs Changes events as JSON to url.
+func NewWebhookNotifier(url string) *WebhookNotifier {
+	return &WebhookNotifier{URL: url, Client: &http.Client{Timeout: 10 * time.Second}}
+}
+
+func (n *WebhookNotifier) Name() string { return "webhook" }
+
+func (n *WebhookNotifier) Notify(changes Changes) error {
+	body, err := json.Marshal(changes)
+	if err != nil {
+		return fmt.Errorf("failed to marshal webhook payload: %w", err)
+	}
+
+	resp, err := n.Client.Post(n.URL, "application/json", bytes.NewReader(body))
+	if err != nil {
+		return fmt.Errorf("failed to POST webhook: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode >= 300 {
+		return fmt.Errorf("webhook returned unexpected status code: %d", resp.StatusCode)
+	}
+	return nil
+}
@@ -0,0 +1,121 @@
+package providers
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"log"
+	"net/http"
+	"strings"
+)
+
+const bugcrowdDataURL = "https://raw.githubusercontent.com/arkadiyt/bounty-targets-data/main/data/bugcrowd_data.json"
+
+// bugcrowdProgram mirrors the upstream bugcrowd_data.json program shape.
+type bugcrowdProgram struct {
+	Name             string          `json:"name"`
+	URL              string          `json:"url"`
+	Targets          bugcrowdTargets `json:"targets"`
+	OffersBounties   bool            `json:"offers_bounties"`
+	AllowsDisclosure bool            `json:"allows_disclosure"`
+}
+
+type bugcrowdTargets struct {
+	InScope    []bugcrowdTarget `json:"in_scope"`
+	OutOfScope []bugcrowdTarget `json:"out_of_scope"`
+}
+
+// bugcrowdTarget uses "category" (e.g. "website", "api", "mobile") rather than
+// HackerOne's asset_type, and has no single eligible-for-bounty flag per target.
+type bugcrowdTarget struct {
+	Target   string `json:"target"`
+	Type     string `json:"type"`
+	Category string `json:"category"`
+}
+
+// bugcrowdProvider fetches and normalizes Bugcrowd's published program/scope data.
+type bugcrowdProvider struct{}
+
+// NewBugcrowdProvider returns a Provider backed by bounty-targets-data's bugcrowd_data.json.
+func NewBugcrowdProvider() Provider {
+	return bugcrowdProvider{}
+}
+
+func (bugcrowdProvider) Name() string     { return "bugcrowd" }
+func (bugcrowdProvider) CacheKey() string { return "bugcrowd.cache" }
+
+func (bugcrowdProvider) Fetch() ([]NormalizedProgram, error) {
+	log.Println("Fetching data from", bugcrowdDataURL)
+	resp, err := http.Get(bugcrowdDataURL)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("unexpected status code: %d", resp.StatusCode)
+	}
+
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, err
+	}
+
+	var raw []bugcrowdProgram
+	if err := json.Unmarshal(body, &raw); err != nil {
+		return nil, err
+	}
+
+	log.Printf("Successfully fetched bugcrowd data: %d programs found", len(raw))
+
+	programs := make([]NormalizedProgram, 0, len(raw))
+	for _, p := range raw {
+		// Bugcrowd has no stable "handle" field like HackerOne; the program URL slug
+		// is the closest thing to one and is what upstream itself keys programs by.
+		handle := strings.TrimPrefix(p.URL, "https://bugcrowd.com/")
+		handle = strings.Trim(handle, "/")
+
+		programs = append(programs, NormalizedProgram{
+			Platform:        "bugcrowd",
+			Handle:          handle,
+			Name:            p.Name,
+			URL:             p.URL,
+			OffersBounties:  p.OffersBounties,
+			SubmissionState: "open",
+			ManagedProgram:  false,
+			InScope:         normalizeBugcrowdTargets(p.Targets.InScope),
+			OutOfScope:      normalizeBugcrowdTargets(p.Targets.OutOfScope),
+		})
+	}
+
+	return programs, nil
+}
+
+func normalizeBugcrowdTargets(targets []bugcrowdTarget) []NormalizedScope {
+	scopes := make([]NormalizedScope, 0, len(targets))
+	for _, t := range targets {
+		scopes = append(scopes, NormalizedScope{
+			AssetIdentifier: t.Target,
+			AssetType:       normalizeBugcrowdAssetType(t.Category, t.Type),
+		})
+	}
+	return scopes
+}
+
+// normalizeBugcrowdAssetType maps Bugcrowd's free-form "category"/"type" fields onto
+// the same asset type vocabulary HackerOne uses (URL, WILDCARD, API, IP_ADDRESS, ...).
+func normalizeBugcrowdAssetType(category, typ string) string {
+	switch strings.ToLower(category) {
+	case "website":
+		return "URL"
+	case "api":
+		return "API"
+	case "ip address", "ip_address":
+		return "IP_ADDRESS"
+	case "other":
+		if strings.Contains(strings.ToLower(typ), "wildcard") {
+			return "WILDCARD"
+		}
+	}
+	return strings.ToUpper(category)
+}
@@ -0,0 +1,262 @@
+package providers
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"log"
+	"net/http"
+	"strings"
+)
+
+const hackeroneDataURL = "https://raw.githubusercontent.com/arkadiyt/bounty-targets-data/main/data/hackerone_data.json"
+
+// hackeroneProgram mirrors the upstream hackerone_data.json program shape.
+type hackeroneProgram struct {
+	Handle          string         `json:"handle"`
+	Name            string         `json:"name"`
+	URL             string         `json:"url"`
+	OffersBounties  bool           `json:"offers_bounties"`
+	SubmissionState string         `json:"submission_state"`
+	ManagedProgram  bool           `json:"managed_program"`
+	Targets         hackeroneScope `json:"targets"`
+}
+
+type hackeroneScope struct {
+	InScope    []hackeroneTarget `json:"in_scope"`
+	OutOfScope []hackeroneTarget `json:"out_of_scope"`
+}
+
+type hackeroneTarget struct {
+	AssetIdentifier   string `json:"asset_identifier"`
+	AssetType         string `json:"asset_type"`
+	EligibleForBounty bool   `json:"eligible_for_bounty"`
+	Instruction       string `json:"instruction"`
+	MaxSeverity       string `json:"max_severity"`
+}
+
+// hackeroneProvider fetches and normalizes HackerOne's published program/scope data.
+type hackeroneProvider struct{}
+
+// NewHackeroneProvider returns a Provider backed by bounty-targets-data's hackerone_data.json.
+func NewHackeroneProvider() Provider {
+	return hackeroneProvider{}
+}
+
+func (hackeroneProvider) Name() string     { return "hackerone" }
+func (hackeroneProvider) CacheKey() string { return "hackerone.cache" }
+
+func (hackeroneProvider) Fetch() ([]NormalizedProgram, error) {
+	log.Println("Fetching data from", hackeroneDataURL)
+	resp, err := http.Get(hackeroneDataURL)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("unexpected status code: %d", resp.StatusCode)
+	}
+
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, err
+	}
+
+	var raw []hackeroneProgram
+	if err := json.Unmarshal(body, &raw); err != nil {
+		return nil, err
+	}
+
+	log.Printf("Successfully fetched hackerone data: %d programs found", len(raw))
+
+	programs := make([]NormalizedProgram, 0, len(raw))
+	for _, p := range raw {
+		programs = append(programs, NormalizedProgram{
+			Platform:        "hackerone",
+			Handle:          p.Handle,
+			Name:            p.Name,
+			URL:             p.URL,
+			OffersBounties:  p.OffersBounties,
+			SubmissionState: p.SubmissionState,
+			ManagedProgram:  p.ManagedProgram,
+			InScope:         normalizeHackeroneTargets(p.Targets.InScope),
+			OutOfScope:      normalizeHackeroneTargets(p.Targets.OutOfScope),
+		})
+	}
+
+	return programs, nil
+}
+
+func normalizeHackeroneTargets(targets []hackeroneTarget) []NormalizedScope {
+	scopes := make([]NormalizedScope, 0, len(targets))
+	for _, t := range targets {
+		scopes = append(scopes, NormalizedScope{
+			AssetIdentifier:   t.AssetIdentifier,
+			AssetType:         t.AssetType,
+			EligibleForBounty: t.EligibleForBounty,
+			Instruction:       t.Instruction,
+			MaxSeverity:       t.MaxSeverity,
+		})
+	}
+	return scopes
+}
+
+// FetchAndDiff streams hackerone_data.json with json.Decoder instead of
+// reading the whole body and unmarshalling it into one slice. Each decoded
+// program is diffed against idx and its scope set rewritten in the same pass,
+// so the only things held in memory at once are the current batch (at most
+// batchSize programs) and whatever emit's caller chooses to keep.
+func (hackeroneProvider) FetchAndDiff(idx ScopeIndex, batchSize int, emit func(ProgramDiff)) error {
+	log.Println("Streaming data from", hackeroneDataURL)
+	resp, err := http.Get(hackeroneDataURL)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return fmt.Errorf("unexpected status code: %d", resp.StatusCode)
+	}
+
+	dec := json.NewDecoder(resp.Body)
+	if err := expectDelim(dec, '['); err != nil {
+		return fmt.Errorf("unexpected top-level JSON: %w", err)
+	}
+
+	batch := make([]hackeroneProgram, 0, batchSize)
+	flush := func() error {
+		for _, raw := range batch {
+			diff, err := diffHackeroneProgram(idx, raw)
+			if err != nil {
+				return err
+			}
+			if diff != nil {
+				emit(*diff)
+			}
+		}
+		batch = batch[:0]
+		return nil
+	}
+
+	count := 0
+	for dec.More() {
+		var p hackeroneProgram
+		if err := dec.Decode(&p); err != nil {
+			return err
+		}
+		batch = append(batch, p)
+		count++
+
+		if len(batch) >= batchSize {
+			if err := flush(); err != nil {
+				return err
+			}
+		}
+	}
+	if err := flush(); err != nil {
+		return err
+	}
+
+	log.Printf("Successfully streamed hackerone data: %d programs processed", count)
+	return expectDelim(dec, ']')
+}
+
+// expectDelim consumes the next JSON token and errors unless it's the given delimiter.
+func expectDelim(dec *json.Decoder, want json.Delim) error {
+	tok, err := dec.Token()
+	if err != nil {
+		return err
+	}
+	delim, ok := tok.(json.Delim)
+	if !ok || delim != want {
+		return fmt.Errorf("got token %v, want delimiter %q", tok, want)
+	}
+	return nil
+}
+
+// diffHackeroneProgram normalizes raw, looks up its prior scope set in idx,
+// records its current scope set back into idx, and reports what changed (a new
+// program, or added and removed scopes) -- nil if the program is closed or
+// nothing changed.
+func diffHackeroneProgram(idx ScopeIndex, raw hackeroneProgram) (*ProgramDiff, error) {
+	program := NormalizedProgram{
+		Platform:        "hackerone",
+		Handle:          raw.Handle,
+		Name:            raw.Name,
+		URL:             raw.URL,
+		OffersBounties:  raw.OffersBounties,
+		SubmissionState: raw.SubmissionState,
+		ManagedProgram:  raw.ManagedProgram,
+		InScope:         normalizeHackeroneTargets(raw.Targets.InScope),
+		OutOfScope:      normalizeHackeroneTargets(raw.Targets.OutOfScope),
+	}
+
+	if program.SubmissionState != "open" {
+		return nil, nil
+	}
+
+	currentKeys := relevantScopeKeys(program.InScope)
+
+	priorKeys, existed, err := idx.PriorScopes(program.Handle)
+	if err != nil {
+		return nil, err
+	}
+	if err := idx.PutScopes(program.Handle, currentKeys); err != nil {
+		return nil, err
+	}
+
+	if !existed {
+		return &ProgramDiff{Program: program, IsNew: true}, nil
+	}
+
+	prior := make(map[string]bool, len(priorKeys))
+	for _, key := range priorKeys {
+		prior[key] = true
+	}
+	current := make(map[string]bool, len(currentKeys))
+	for _, key := range currentKeys {
+		current[key] = true
+	}
+
+	var newScopes []NormalizedScope
+	for _, scope := range program.InScope {
+		if !IsRelevantAssetType(scope.AssetType) {
+			continue
+		}
+		if key := scope.AssetType + ":" + scope.AssetIdentifier; !prior[key] {
+			newScopes = append(newScopes, scope)
+		}
+	}
+
+	var removedScopes []NormalizedScope
+	for _, key := range priorKeys {
+		if current[key] {
+			continue
+		}
+		assetType, identifier, ok := strings.Cut(key, ":")
+		if !ok {
+			continue
+		}
+		removedScopes = append(removedScopes, NormalizedScope{AssetType: assetType, AssetIdentifier: identifier})
+	}
+
+	if len(newScopes) == 0 && len(removedScopes) == 0 {
+		return nil, nil
+	}
+
+	return &ProgramDiff{Program: program, NewScopes: newScopes, RemovedScopes: removedScopes}, nil
+}
+
+// relevantScopeKeys renders a program's relevant in-scope targets as
+// "assettype:identifier" keys, in input order; the ScopeIndex sorts them when
+// they're stored.
+func relevantScopeKeys(scopes []NormalizedScope) []string {
+	keys := make([]string, 0, len(scopes))
+	for _, scope := range scopes {
+		if IsRelevantAssetType(scope.AssetType) {
+			keys = append(keys, scope.AssetType+":"+scope.AssetIdentifier)
+		}
+	}
+	return keys
+}
@@ -0,0 +1,131 @@
+package providers
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"log"
+	"net/http"
+)
+
+const intigritiDataURL = "https://raw.githubusercontent.com/arkadiyt/bounty-targets-data/main/data/intigriti_data.json"
+
+// intigriti category codes, per bounty-targets-data's intigriti_data.json. Intigriti
+// reports scope as numeric categories rather than a string asset type.
+const (
+	intigritiCategoryURL      = 1
+	intigritiCategoryWildcard = 2
+	intigritiCategoryAndroid  = 6
+	intigritiCategoryIOS      = 7
+	intigritiCategoryOther    = 11
+)
+
+// intigritiProgram mirrors the upstream intigriti_data.json program shape.
+type intigritiProgram struct {
+	Handle  string            `json:"handle"`
+	Name    string            `json:"name"`
+	Status  string            `json:"status"`
+	Targets []intigritiTarget `json:"targets"`
+}
+
+// intigritiTarget has no in/out-of-scope split in the same shape as HackerOne;
+// "tier" carries the eligibility/severity equivalent and "type" is numeric.
+type intigritiTarget struct {
+	Endpoint   string `json:"endpoint"`
+	Type       int    `json:"type"`
+	Tier       string `json:"tier"`
+	OutOfScope bool   `json:"out_of_scope"`
+}
+
+// intigritiProvider fetches and normalizes Intigriti's published program/scope data.
+type intigritiProvider struct{}
+
+// NewIntigritiProvider returns a Provider backed by bounty-targets-data's intigriti_data.json.
+func NewIntigritiProvider() Provider {
+	return intigritiProvider{}
+}
+
+func (intigritiProvider) Name() string     { return "intigriti" }
+func (intigritiProvider) CacheKey() string { return "intigriti.cache" }
+
+func (intigritiProvider) Fetch() ([]NormalizedProgram, error) {
+	log.Println("Fetching data from", intigritiDataURL)
+	resp, err := http.Get(intigritiDataURL)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("unexpected status code: %d", resp.StatusCode)
+	}
+
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, err
+	}
+
+	var raw []intigritiProgram
+	if err := json.Unmarshal(body, &raw); err != nil {
+		return nil, err
+	}
+
+	log.Printf("Successfully fetched intigriti data: %d programs found", len(raw))
+
+	programs := make([]NormalizedProgram, 0, len(raw))
+	for _, p := range raw {
+		var inScope, outOfScope []NormalizedScope
+		for _, t := range p.Targets {
+			scope := NormalizedScope{
+				AssetIdentifier: t.Endpoint,
+				AssetType:       normalizeIntigritiCategory(t.Type),
+				MaxSeverity:     t.Tier,
+			}
+			if t.OutOfScope {
+				outOfScope = append(outOfScope, scope)
+			} else {
+				inScope = append(inScope, scope)
+			}
+		}
+
+		programs = append(programs, NormalizedProgram{
+			Platform:        "intigriti",
+			Handle:          p.Handle,
+			Name:            p.Name,
+			URL:             "https://app.intigriti.com/programs/" + p.Handle,
+			SubmissionState: normalizeIntigritiStatus(p.Status),
+			InScope:         inScope,
+			OutOfScope:      outOfScope,
+		})
+	}
+
+	return programs, nil
+}
+
+// normalizeIntigritiCategory maps Intigriti's numeric category codes onto the same
+// asset type vocabulary HackerOne uses (URL, WILDCARD, API, IP_ADDRESS, ...).
+func normalizeIntigritiCategory(category int) string {
+	switch category {
+	case intigritiCategoryURL:
+		return "URL"
+	case intigritiCategoryWildcard:
+		return "WILDCARD"
+	case intigritiCategoryAndroid:
+		return "ANDROID"
+	case intigritiCategoryIOS:
+		return "IOS"
+	default:
+		return "OTHER"
+	}
+}
+
+// normalizeIntigritiStatus maps Intigriti's status strings onto the submission_state
+// values the rest of bounty-monitor already filters on (only "open" programs count).
+func normalizeIntigritiStatus(status string) string {
+	switch status {
+	case "open":
+		return "open"
+	default:
+		return status
+	}
+}
@@ -0,0 +1,110 @@
+// Package providers abstracts the different upstream bug bounty platforms that
+// bounty-monitor can watch for scope changes. Each platform publishes its program
+// and scope data in its own JSON shape (see the bounty-targets-data repo), so every
+// Provider implementation is responsible for normalizing its platform's quirks into
+// the shared NormalizedProgram/NormalizedScope model the rest of the tool diffs on.
+package providers
+
+import "strings"
+
+// NormalizedScope represents a single in-scope or out-of-scope target, normalized
+// across all upstream platforms into the asset-type vocabulary bounty-monitor
+// already understands (URL, WILDCARD, CIDR, IP_ADDRESS, API, ...).
+type NormalizedScope struct {
+	AssetIdentifier   string
+	AssetType         string
+	EligibleForBounty bool
+	Instruction       string
+	MaxSeverity       string
+}
+
+// NormalizedProgram represents a bug bounty program from any upstream provider,
+// normalized into the shape the rest of bounty-monitor diffs and notifies on.
+type NormalizedProgram struct {
+	Platform        string
+	Handle          string
+	Name            string
+	URL             string
+	OffersBounties  bool
+	SubmissionState string
+	ManagedProgram  bool
+	InScope         []NormalizedScope
+	OutOfScope      []NormalizedScope
+}
+
+// Provider fetches and normalizes a bug bounty platform's public program/scope data.
+type Provider interface {
+	// Name returns the short, lowercase identifier for the provider (e.g. "hackerone").
+	Name() string
+	// CacheKey returns the filename, relative to the cache directory, used to persist
+	// this provider's previous snapshot.
+	CacheKey() string
+	// Fetch downloads and normalizes the provider's current program/scope data.
+	Fetch() ([]NormalizedProgram, error)
+}
+
+// All returns every provider bounty-monitor knows how to talk to, in a stable order.
+// Callers (runCheck via the --config file's providers list, etc.) filter this
+// down to the enabled set.
+func All() []Provider {
+	return []Provider{
+		NewHackeroneProvider(),
+		NewBugcrowdProvider(),
+		NewIntigritiProvider(),
+		NewYesWeHackProvider(),
+	}
+}
+
+// ByName returns the registered provider with the given name, or false if unknown.
+func ByName(name string) (Provider, bool) {
+	for _, p := range All() {
+		if p.Name() == name {
+			return p, true
+		}
+	}
+	return nil, false
+}
+
+// IsRelevantAssetType reports whether scopes of this asset type are worth
+// monitoring for new additions (URL, WILDCARD, CIDR, IP_ADDRESS, API).
+func IsRelevantAssetType(assetType string) bool {
+	switch strings.ToUpper(assetType) {
+	case "URL", "WILDCARD", "CIDR", "IP_ADDRESS", "API":
+		return true
+	default:
+		return false
+	}
+}
+
+// ScopeIndex is an on-disk, per-handle store of a program's most recently
+// recorded scope set, keyed by program handle. It's declared here (rather than
+// imported) so this package doesn't need to depend on whatever storage backs
+// it; scopeindex.Index satisfies it.
+type ScopeIndex interface {
+	// PriorScopes returns the "assettype:identifier" keys last recorded for
+	// handle, and whether handle had any record at all.
+	PriorScopes(handle string) ([]string, bool, error)
+	// PutScopes replaces the recorded scope set for handle.
+	PutScopes(handle string, keys []string) error
+}
+
+// ProgramDiff is one program's worth of incremental diff output from a
+// StreamingDiffer: either a brand new program, or the newly-appeared and
+// removed scopes on an existing one.
+type ProgramDiff struct {
+	Program       NormalizedProgram
+	IsNew         bool
+	NewScopes     []NormalizedScope
+	RemovedScopes []NormalizedScope
+}
+
+// StreamingDiffer is implemented by providers whose upstream feed is large
+// enough that decoding it fully into memory -- and building a second full
+// slice just to diff against -- is wasteful. FetchAndDiff streams the feed
+// program-by-program, diffs each one against idx immediately, and records its
+// new scope set back into idx in the same pass, calling emit with any program
+// that is new or whose scope set changed. At most batchSize decoded programs are held in memory
+// before being diffed and released.
+type StreamingDiffer interface {
+	FetchAndDiff(idx ScopeIndex, batchSize int, emit func(ProgramDiff)) error
+}
@@ -0,0 +1,111 @@
+package providers
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"path/filepath"
+	"testing"
+
+	"github.com/admiralhr99/bountyMonitor/scopeindex"
+)
+
+// fixture builds a hackerone_data.json-shaped body with numPrograms programs,
+// each carrying scopesPerProgram in-scope targets, for comparing the old
+// full-load path against the streaming path at realistic scale.
+func fixture(numPrograms, scopesPerProgram int) []byte {
+	raw := make([]hackeroneProgram, 0, numPrograms)
+	for i := 0; i < numPrograms; i++ {
+		targets := make([]hackeroneTarget, 0, scopesPerProgram)
+		for j := 0; j < scopesPerProgram; j++ {
+			targets = append(targets, hackeroneTarget{
+				AssetIdentifier: fmt.Sprintf("asset-%d-%d.example.com", i, j),
+				AssetType:       "WILDCARD",
+			})
+		}
+		raw = append(raw, hackeroneProgram{
+			Handle:          fmt.Sprintf("program-%d", i),
+			Name:            fmt.Sprintf("Program %d", i),
+			URL:             fmt.Sprintf("https://hackerone.com/program-%d", i),
+			SubmissionState: "open",
+			Targets:         hackeroneScope{InScope: targets},
+		})
+	}
+
+	body, err := json.Marshal(raw)
+	if err != nil {
+		panic(err)
+	}
+	return body
+}
+
+// seedPriorScopes pre-populates idx with the same scope set fixture(numPrograms,
+// scopesPerProgram) produces, so a benchmark against it measures a steady-state
+// repeat check -- PriorScopes hits and an existing-program diff -- instead of
+// every program's cheap first-seen path.
+func seedPriorScopes(b *testing.B, idx *scopeindex.Index, numPrograms, scopesPerProgram int) {
+	b.Helper()
+	for i := 0; i < numPrograms; i++ {
+		keys := make([]string, 0, scopesPerProgram)
+		for j := 0; j < scopesPerProgram; j++ {
+			keys = append(keys, fmt.Sprintf("WILDCARD:asset-%d-%d.example.com", i, j))
+		}
+		if err := idx.PutScopes(fmt.Sprintf("program-%d", i), keys); err != nil {
+			b.Fatal(err)
+		}
+	}
+}
+
+// BenchmarkFullLoad measures the original Fetch path's decode step: read the
+// whole body, then json.Unmarshal it into one []hackeroneProgram slice.
+func BenchmarkFullLoad(b *testing.B) {
+	body := fixture(500, 100) // ~50k scopes total
+
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		var raw []hackeroneProgram
+		if err := json.Unmarshal(body, &raw); err != nil {
+			b.Fatal(err)
+		}
+	}
+}
+
+// BenchmarkStreamingDecode measures FetchAndDiff's real decode-and-diff path:
+// each decoded program is diffed against a real, tmpfile-backed scopeindex.Index
+// pre-populated with its prior scope set, so the benchmark pays for
+// PriorScopes/PutScopes's actual bbolt transactions and the diff-map
+// bookkeeping diffHackeroneProgram does, not just decode cost (FetchAndDiff
+// itself isn't called directly since it fetches over HTTP from a fixed URL).
+func BenchmarkStreamingDecode(b *testing.B) {
+	numPrograms, scopesPerProgram := 500, 100 // ~50k scopes total
+	body := fixture(numPrograms, scopesPerProgram)
+
+	idx, err := scopeindex.Open(filepath.Join(b.TempDir(), "bench.bolt"))
+	if err != nil {
+		b.Fatal(err)
+	}
+	defer idx.Close()
+	seedPriorScopes(b, idx, numPrograms, scopesPerProgram)
+
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		dec := json.NewDecoder(bytes.NewReader(body))
+		if err := expectDelim(dec, '['); err != nil {
+			b.Fatal(err)
+		}
+
+		for dec.More() {
+			var p hackeroneProgram
+			if err := dec.Decode(&p); err != nil {
+				b.Fatal(err)
+			}
+			if _, err := diffHackeroneProgram(idx, p); err != nil {
+				b.Fatal(err)
+			}
+		}
+
+		if err := expectDelim(dec, ']'); err != nil {
+			b.Fatal(err)
+		}
+	}
+}
@@ -0,0 +1,111 @@
+package providers
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"log"
+	"net/http"
+)
+
+const yeswehackDataURL = "https://raw.githubusercontent.com/arkadiyt/bounty-targets-data/main/data/yeswehack_data.json"
+
+// yeswehackProgram mirrors the upstream yeswehack_data.json program shape, which is
+// close to HackerOne's but keys programs by slug instead of handle and has no
+// managed_program flag.
+type yeswehackProgram struct {
+	Slug     string            `json:"slug"`
+	Name     string            `json:"name"`
+	Disabled bool              `json:"disabled"`
+	Public   bool              `json:"public"`
+	Scopes   []yeswehackTarget `json:"scopes"`
+}
+
+type yeswehackTarget struct {
+	Scope      string `json:"scope"`
+	ScopeType  string `json:"scope_type"`
+	BountyLow  int    `json:"bounty_low"`
+	BountyHigh int    `json:"bounty_high"`
+}
+
+// yeswehackProvider fetches and normalizes YesWeHack's published program/scope data.
+type yeswehackProvider struct{}
+
+// NewYesWeHackProvider returns a Provider backed by bounty-targets-data's yeswehack_data.json.
+func NewYesWeHackProvider() Provider {
+	return yeswehackProvider{}
+}
+
+func (yeswehackProvider) Name() string     { return "yeswehack" }
+func (yeswehackProvider) CacheKey() string { return "yeswehack.cache" }
+
+func (yeswehackProvider) Fetch() ([]NormalizedProgram, error) {
+	log.Println("Fetching data from", yeswehackDataURL)
+	resp, err := http.Get(yeswehackDataURL)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("unexpected status code: %d", resp.StatusCode)
+	}
+
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, err
+	}
+
+	var raw []yeswehackProgram
+	if err := json.Unmarshal(body, &raw); err != nil {
+		return nil, err
+	}
+
+	log.Printf("Successfully fetched yeswehack data: %d programs found", len(raw))
+
+	programs := make([]NormalizedProgram, 0, len(raw))
+	for _, p := range raw {
+		state := "open"
+		if p.Disabled || !p.Public {
+			state = "disabled"
+		}
+
+		scopes := make([]NormalizedScope, 0, len(p.Scopes))
+		for _, t := range p.Scopes {
+			scopes = append(scopes, NormalizedScope{
+				AssetIdentifier:   t.Scope,
+				AssetType:         normalizeYesWeHackScopeType(t.ScopeType),
+				EligibleForBounty: t.BountyHigh > 0,
+			})
+		}
+
+		programs = append(programs, NormalizedProgram{
+			Platform:        "yeswehack",
+			Handle:          p.Slug,
+			Name:            p.Name,
+			URL:             "https://yeswehack.com/programs/" + p.Slug,
+			OffersBounties:  true,
+			SubmissionState: state,
+			InScope:         scopes,
+		})
+	}
+
+	return programs, nil
+}
+
+// normalizeYesWeHackScopeType maps YesWeHack's scope_type strings onto the same
+// asset type vocabulary HackerOne uses (URL, WILDCARD, API, IP_ADDRESS, ...).
+func normalizeYesWeHackScopeType(scopeType string) string {
+	switch scopeType {
+	case "web-application":
+		return "URL"
+	case "api":
+		return "API"
+	case "ip-address":
+		return "IP_ADDRESS"
+	case "mobile-application":
+		return "MOBILE"
+	default:
+		return "OTHER"
+	}
+}
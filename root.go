@@ -0,0 +1,54 @@
+package main
+
+import (
+	"log"
+	"os"
+
+	"github.com/spf13/cobra"
+
+	"github.com/admiralhr99/bountyMonitor/config"
+)
+
+// configPath is shared by every subcommand via the --config persistent flag.
+var configPath string
+
+var rootCmd = &cobra.Command{
+	Use:   "bounty-monitor",
+	Short: "Watch bug bounty programs across multiple platforms for scope changes",
+}
+
+func init() {
+	rootCmd.PersistentFlags().StringVar(&configPath, "config", "bounty-monitor.yaml", "path to the YAML config file (providers, notifiers, silence rules)")
+
+	rootCmd.AddCommand(monitorCmd)
+	rootCmd.AddCommand(checkCmd)
+	rootCmd.AddCommand(diffCmd)
+	rootCmd.AddCommand(listCmd)
+	rootCmd.AddCommand(exportCmd)
+	rootCmd.AddCommand(dumpCmd)
+	rootCmd.AddCommand(notifyCmd)
+	rootCmd.AddCommand(historyCmd)
+}
+
+// loadConfig loads the shared --config file. A missing file isn't an error: it
+// falls back to every provider enabled, a single file notifier, and no silence
+// rules, so the tool keeps working unconfigured.
+func loadConfig() (*config.Config, error) {
+	if _, err := os.Stat(configPath); os.IsNotExist(err) {
+		return &config.Config{}, nil
+	}
+	return config.Load(configPath)
+}
+
+// setupLogging mirrors the original tool's always-log-to-file behavior. The
+// caller is responsible for closing the returned file.
+func setupLogging() (*os.File, error) {
+	logFile, err := os.OpenFile("bounty-monitor.log", os.O_CREATE|os.O_WRONLY|os.O_APPEND, 0666)
+	if err != nil {
+		return nil, err
+	}
+
+	log.SetOutput(logFile)
+	log.SetFlags(log.Ldate | log.Ltime | log.Lshortfile)
+	return logFile, nil
+}
@@ -0,0 +1,75 @@
+// Package scopeindex stores each program's most recently seen scope set on
+// disk in a bbolt bucket keyed by program handle, so a streaming diff can look
+// up and update one program at a time instead of holding a whole provider's
+// prior snapshot in memory.
+package scopeindex
+
+import (
+	"sort"
+	"strings"
+
+	bolt "go.etcd.io/bbolt"
+)
+
+var bucketName = []byte("scopes")
+
+// Index is a bbolt-backed store of per-handle scope sets, each rendered as a
+// sorted "assettype:identifier" list.
+type Index struct {
+	db *bolt.DB
+}
+
+// Open opens (creating if necessary) a bbolt-backed scope index at path.
+func Open(path string) (*Index, error) {
+	db, err := bolt.Open(path, 0644, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	err = db.Update(func(tx *bolt.Tx) error {
+		_, err := tx.CreateBucketIfNotExists(bucketName)
+		return err
+	})
+	if err != nil {
+		db.Close()
+		return nil, err
+	}
+
+	return &Index{db: db}, nil
+}
+
+// Close closes the underlying database.
+func (idx *Index) Close() error {
+	return idx.db.Close()
+}
+
+// PriorScopes returns the "assettype:identifier" keys last recorded for
+// handle, and whether handle had any record at all.
+func (idx *Index) PriorScopes(handle string) ([]string, bool, error) {
+	var keys []string
+	var found bool
+
+	err := idx.db.View(func(tx *bolt.Tx) error {
+		v := tx.Bucket(bucketName).Get([]byte(handle))
+		if v == nil {
+			return nil
+		}
+		found = true
+		if len(v) > 0 {
+			keys = strings.Split(string(v), "\n")
+		}
+		return nil
+	})
+
+	return keys, found, err
+}
+
+// PutScopes replaces the recorded scope set for handle with a sorted copy of keys.
+func (idx *Index) PutScopes(handle string, keys []string) error {
+	sorted := append([]string(nil), keys...)
+	sort.Strings(sorted)
+
+	return idx.db.Update(func(tx *bolt.Tx) error {
+		return tx.Bucket(bucketName).Put([]byte(handle), []byte(strings.Join(sorted, "\n")))
+	})
+}